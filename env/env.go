@@ -1,5 +1,6 @@
 /*
-Package env provides a simple way to get environment variables.
+Package env provides a simple way to get environment variables,
+either one at a time or loaded into a struct with Load.
 */
 package env
 
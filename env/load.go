@@ -0,0 +1,240 @@
+package env
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrRequired is reported for required variables that are not set or empty.
+var ErrRequired = errors.New("required variable is not set")
+
+// VarError describes a variable that is missing or cannot be parsed.
+type VarError struct {
+	Name  string
+	Value string
+	Err   error
+}
+
+func (e *VarError) Error() string {
+	if errors.Is(e.Err, ErrRequired) {
+		return fmt.Sprintf("%s: %v", e.Name, e.Err)
+	}
+	return fmt.Sprintf("%s=%q: %v", e.Name, e.Value, e.Err)
+}
+
+func (e *VarError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	urlType             = reflect.TypeOf(url.URL{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Load populates the struct pointed to by cfg from environment variables, using field tags:
+//
+//	env:"NAME"           the variable read into the field; fields without it are left untouched
+//	default:"value"      the value used when the variable is not set or empty
+//	required:"true"      reports an error when the variable is empty or not set, and has no default
+//	envPrefix:"PREFIX_"  on a struct field, prefixes the variable names of its fields
+//
+// Supported field types are strings, bools, ints, uints, floats, time.Duration, url.URL,
+// encoding.TextUnmarshaler implementations, pointers to these, comma separated slices,
+// comma separated key:value maps and nested structs.
+//
+// Load sets every field it can, and returns an error joining a *VarError for each
+// missing or malformed variable.
+func Load(cfg any) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("env: Load requires a non-nil pointer to a struct, got %T", cfg)
+	}
+
+	return errors.Join(loadStruct(v.Elem(), "")...)
+}
+
+func loadStruct(v reflect.Value, prefix string) []error {
+	var errs []error
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := field.Tag.Lookup("env")
+		if !ok {
+			if nested, ok := nestedStruct(value); ok {
+				errs = append(errs, loadStruct(nested, prefix+field.Tag.Get("envPrefix"))...)
+			}
+			continue
+		}
+
+		if err := loadField(value, field, prefix+name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// nestedStruct returns the struct a field without an env tag holds, allocating nil pointers.
+func nestedStruct(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Pointer && v.Type().Elem().Kind() == reflect.Struct {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct || v.Type() == urlType {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+func loadField(v reflect.Value, field reflect.StructField, name string) error {
+	// A variable set to an empty value, e.g. FOO=, counts as not set
+	raw := os.Getenv(name)
+	if raw == "" {
+		raw = field.Tag.Get("default")
+	}
+
+	if raw == "" {
+		if required, _ := strconv.ParseBool(field.Tag.Get("required")); required {
+			return &VarError{Name: name, Err: ErrRequired}
+		}
+		return nil
+	}
+
+	if err := setValue(v, raw); err != nil {
+		return &VarError{Name: name, Value: raw, Err: err}
+	}
+	return nil
+}
+
+func setValue(v reflect.Value, raw string) error {
+	t := v.Type()
+
+	// Pointers are allocated so that their element can be parsed
+	if t.Kind() == reflect.Pointer {
+		elem := reflect.New(t.Elem())
+		if err := setValue(elem.Elem(), raw); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	switch {
+	case t == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %v", err)
+		}
+		v.SetInt(int64(d))
+		return nil
+	case t == urlType:
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid URL: %v", err)
+		}
+		v.Set(reflect.ValueOf(*u))
+		return nil
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		if err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw)); err != nil {
+			return fmt.Errorf("invalid %s: %v", t, err)
+		}
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid bool: %v", err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, t.Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", t.Kind(), err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, t.Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", t.Kind(), err)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, t.Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", t.Kind(), err)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		return setSlice(v, raw)
+	case reflect.Map:
+		return setMap(v, raw)
+	default:
+		return fmt.Errorf("unsupported type %s", t)
+	}
+
+	return nil
+}
+
+// setSlice parses comma separated values, e.g. "a,b,c".
+func setSlice(v reflect.Value, raw string) error {
+	parts := strings.Split(raw, ",")
+	slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+
+	for i, part := range parts {
+		if err := setValue(slice.Index(i), strings.TrimSpace(part)); err != nil {
+			return fmt.Errorf("element %d: %v", i, err)
+		}
+	}
+
+	v.Set(slice)
+	return nil
+}
+
+// setMap parses comma separated key:value pairs, e.g. "a:1,b:2".
+func setMap(v reflect.Value, raw string) error {
+	t := v.Type()
+	m := reflect.MakeMap(t)
+
+	for _, pair := range strings.Split(raw, ",") {
+		k, val, ok := strings.Cut(pair, ":")
+		if !ok {
+			return fmt.Errorf("invalid map entry %q, want key:value", pair)
+		}
+
+		key := reflect.New(t.Key()).Elem()
+		if err := setValue(key, strings.TrimSpace(k)); err != nil {
+			return fmt.Errorf("key %q: %v", k, err)
+		}
+
+		elem := reflect.New(t.Elem()).Elem()
+		if err := setValue(elem, strings.TrimSpace(val)); err != nil {
+			return fmt.Errorf("value of %q: %v", k, err)
+		}
+
+		m.SetMapIndex(key, elem)
+	}
+
+	v.Set(m)
+	return nil
+}
@@ -0,0 +1,202 @@
+package env
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+type testRabbitMQConfig struct {
+	Host string `env:"HOST" default:"localhost"`
+	Port int    `env:"PORT" default:"5672"`
+	User string `env:"USER" required:"true"`
+}
+
+type testConfig struct {
+	Name     string             `env:"SERVICE_NAME" default:"trip-service"`
+	Debug    bool               `env:"DEBUG"`
+	Timeout  time.Duration      `env:"TIMEOUT" default:"5s"`
+	Ratio    *float64           `env:"RATIO"`
+	Origins  []string           `env:"ORIGINS"`
+	Weights  map[string]int     `env:"WEIGHTS"`
+	Endpoint url.URL            `env:"ENDPOINT"`
+	RabbitMQ testRabbitMQConfig `envPrefix:"RABBITMQ_"`
+}
+
+var testVars = []string{
+	"SERVICE_NAME", "DEBUG", "TIMEOUT", "RATIO", "ORIGINS", "WEIGHTS", "ENDPOINT",
+	"RABBITMQ_HOST", "RABBITMQ_PORT", "RABBITMQ_USER",
+}
+
+func TestLoad(t *testing.T) {
+	defaults := testConfig{
+		Name:     "trip-service",
+		Timeout:  5 * time.Second,
+		RabbitMQ: testRabbitMQConfig{Host: "localhost", Port: 5672, User: "guest"},
+	}
+	ratio := 0.5
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		// want is compared when wantErrs is empty
+		want testConfig
+		// wantErrs are the names of the variables reported as missing or malformed
+		wantErrs []string
+		// wantRequired lists the wantErrs reported with ErrRequired
+		wantRequired []string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{"RABBITMQ_USER": "guest"},
+			want: defaults,
+		},
+		{
+			name: "empty values use defaults",
+			env:  map[string]string{"RABBITMQ_USER": "guest", "SERVICE_NAME": "", "TIMEOUT": "", "RABBITMQ_PORT": ""},
+			want: defaults,
+		},
+		{
+			name: "all set",
+			env: map[string]string{
+				"SERVICE_NAME":  "driver-service",
+				"DEBUG":         "true",
+				"TIMEOUT":       "1m",
+				"RATIO":         "0.5",
+				"ORIGINS":       "a.com, b.com",
+				"WEIGHTS":       "sedan:1,van:2",
+				"ENDPOINT":      "http://collector:4318",
+				"RABBITMQ_HOST": "rabbitmq",
+				"RABBITMQ_PORT": "5673",
+				"RABBITMQ_USER": "admin",
+			},
+			want: testConfig{
+				Name:     "driver-service",
+				Debug:    true,
+				Timeout:  time.Minute,
+				Ratio:    &ratio,
+				Origins:  []string{"a.com", "b.com"},
+				Weights:  map[string]int{"sedan": 1, "van": 2},
+				Endpoint: url.URL{Scheme: "http", Host: "collector:4318"},
+				RabbitMQ: testRabbitMQConfig{Host: "rabbitmq", Port: 5673, User: "admin"},
+			},
+		},
+		{
+			name:         "required not set",
+			env:          map[string]string{},
+			wantErrs:     []string{"RABBITMQ_USER"},
+			wantRequired: []string{"RABBITMQ_USER"},
+		},
+		{
+			name:         "required empty",
+			env:          map[string]string{"RABBITMQ_USER": ""},
+			wantErrs:     []string{"RABBITMQ_USER"},
+			wantRequired: []string{"RABBITMQ_USER"},
+		},
+		{
+			name:     "malformed port",
+			env:      map[string]string{"RABBITMQ_USER": "guest", "RABBITMQ_PORT": "56x2"},
+			wantErrs: []string{"RABBITMQ_PORT"},
+		},
+		{
+			name:         "every error is reported",
+			env:          map[string]string{"DEBUG": "maybe", "TIMEOUT": "5", "WEIGHTS": "sedan", "RABBITMQ_PORT": "56x2"},
+			wantErrs:     []string{"DEBUG", "TIMEOUT", "WEIGHTS", "RABBITMQ_PORT", "RABBITMQ_USER"},
+			wantRequired: []string{"RABBITMQ_USER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range testVars {
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			var got testConfig
+			err := Load(&got)
+
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Load() error = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Load() = %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+
+			gotErrs := varErrors(err)
+			var names []string
+			for _, e := range gotErrs {
+				names = append(names, e.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantErrs) {
+				t.Fatalf("Load() errors for %q, want %q (error = %v)", names, tt.wantErrs, err)
+			}
+
+			for _, e := range gotErrs {
+				required := errors.Is(e, ErrRequired)
+				wantRequired := slices.Contains(tt.wantRequired, e.Name)
+				if required != wantRequired {
+					t.Errorf("%s: errors.Is(ErrRequired) = %v, want %v", e.Name, required, wantRequired)
+				}
+				if !required && e.Value != tt.env[e.Name] {
+					t.Errorf("%s: Value = %q, want %q", e.Name, e.Value, tt.env[e.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestLoadSetsValidFieldsDespiteErrors(t *testing.T) {
+	t.Setenv("RABBITMQ_HOST", "rabbitmq")
+	t.Setenv("RABBITMQ_PORT", "56x2")
+	t.Setenv("RABBITMQ_USER", "guest")
+
+	var cfg testRabbitMQConfig
+	err := Load(&struct {
+		RabbitMQ *testRabbitMQConfig `envPrefix:"RABBITMQ_"`
+	}{RabbitMQ: &cfg})
+
+	var varErr *VarError
+	if !errors.As(err, &varErr) || varErr.Name != "RABBITMQ_PORT" {
+		t.Fatalf("Load() error = %v, want a *VarError for RABBITMQ_PORT", err)
+	}
+	if cfg.Host != "rabbitmq" || cfg.User != "guest" {
+		t.Errorf("Load() = %+v, want the host and user set", cfg)
+	}
+}
+
+func TestLoadRejectsNonStructPointer(t *testing.T) {
+	var cfg testConfig
+	for _, target := range []any{nil, cfg, (*testConfig)(nil), new(int)} {
+		if err := Load(target); err == nil {
+			t.Errorf("Load(%T) error = nil, want an error", target)
+		}
+	}
+}
+
+// varErrors unwraps the *VarError joined in an error returned by Load, in field order.
+func varErrors(err error) []*VarError {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil
+	}
+
+	var errs []*VarError
+	for _, e := range joined.Unwrap() {
+		var varErr *VarError
+		if errors.As(e, &varErr) {
+			errs = append(errs, varErr)
+		}
+	}
+	return errs
+}
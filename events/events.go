@@ -2,9 +2,14 @@ package events
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/ride4Low/contracts/proto/driver"
+	"github.com/ride4Low/contracts/proto/notification"
+	"github.com/ride4Low/contracts/proto/payment"
+	"github.com/ride4Low/contracts/proto/rating"
 	"github.com/ride4Low/contracts/proto/trip"
+	"github.com/ride4Low/contracts/proto/user"
 )
 
 // AmqpMessage is the standard message format for RabbitMQ messages
@@ -23,6 +28,20 @@ const (
 	PaymentTripResponseQueue         = "payment_trip_response"
 	NotifyPaymentSessionCreatedQueue = "notify_payment_session_created"
 	NotifyPaymentSuccessQueue        = "notify_payment_success"
+	NotifyPaymentFailedQueue         = "notify_payment_failed"
+	NotifyPaymentExpiredQueue        = "notify_payment_expired"
+	NotifyPaymentRefundedQueue       = "notify_payment_refunded"
+	NotifyPaymentDisputedQueue       = "notify_payment_disputed"
+	TripPaymentStatusQueue           = "trip_payment_status"
+	DriverRatingUpdateQueue          = "driver_rating_update"
+	NotificationSendQueue            = "notification_send"
+	NotificationDeliveryStatusQueue  = "notification_delivery_status"
+	NotifyTripScheduledQueue         = "notify_trip_scheduled"
+	NotifyTripCancelledQueue         = "notify_trip_cancelled"
+	NotifyTripCompletedQueue         = "notify_trip_completed"
+	PaymentTripCancelledQueue        = "payment_trip_cancelled"
+	PaymentTripCompletedQueue        = "payment_trip_completed"
+	ScheduledTripDispatchQueue       = "scheduled_trip_dispatch"
 	DeadLetterQueue                  = "dead_letter"
 )
 
@@ -33,6 +52,13 @@ const (
 	TripEventNoDriversFound      = "trip.event.no_drivers_found"
 	TripEventDriverNotInterested = "trip.event.driver_not_interested"
 	TripEventDriverAssigned      = "trip.event.driver_assigned"
+	TripEventCancelled           = "trip.event.cancelled"
+	TripEventCompleted           = "trip.event.completed"
+	TripEventRated               = "trip.event.rated"
+	TripEventScheduled           = "trip.event.scheduled"
+
+	// Trip commands (trip.cmd.*)
+	TripCmdDispatchScheduled = "trip.cmd.dispatch_scheduled"
 
 	// Driver commands (driver.cmd.*)
 	DriverCmdTripRequest = "driver.cmd.trip_request"
@@ -48,6 +74,17 @@ const (
 	// Payment events (payment.event.*)
 	PaymentEventSessionCreated = "payment.event.session_created"
 	PaymentEventSuccess        = "payment.event.success"
+	PaymentEventFailed         = "payment.event.failed"
+	PaymentEventExpired        = "payment.event.expired"
+	PaymentEventRefunded       = "payment.event.refunded"
+	PaymentEventDisputed       = "payment.event.disputed"
+
+	// Notification commands (notification.cmd.*)
+	NotificationCmdSend = "notification.cmd.send"
+
+	// Notification events (notification.event.*)
+	NotificationEventDelivered = "notification.event.delivered"
+	NotificationEventFailed    = "notification.event.failed"
 )
 
 // TripEventData is the payload for trip-related events
@@ -55,40 +92,68 @@ type TripEventData struct {
 	Trip *trip.Trip `json:"trip"`
 }
 
-type DriverTripResponseData struct {
-	Driver  *driver.Driver `json:"driver"`
-	TripID  string         `json:"tripID"`
-	RiderID string         `json:"riderID"`
+// TripScheduledData is the payload for trip.event.scheduled and trip.cmd.dispatch_scheduled
+type TripScheduledData struct {
+	Trip                *trip.Trip `json:"trip"`
+	ScheduledPickupTime time.Time  `json:"scheduledPickupTime"`
+	// DispatchAt is when trip.event.created is emitted to start looking for a driver
+	DispatchAt time.Time `json:"dispatchAt"`
 }
 
-// WS Events
-const (
-	// DriverWSRegister = "driver.ws.register"
-	DriverCmdRegister = "driver.cmd.register"
-)
-
-type PaymentTripResponseData struct {
-	TripID   string  `json:"tripID"`
-	UserID   string  `json:"userID"`
-	DriverID string  `json:"driverID"`
-	Amount   float64 `json:"amount"`
-	Currency string  `json:"currency"`
+// TripCancelledData is the payload for trip.event.cancelled
+type TripCancelledData struct {
+	Trip          *trip.Trip              `json:"trip"`
+	CancelledBy   trip.CancelledBy        `json:"cancelledBy"`
+	CancelledByID string                  `json:"cancelledByID"`
+	Reason        trip.CancellationReason `json:"reason"`
+	Comment       string                  `json:"comment"`
 }
 
-type PaymentEventSessionCreatedData struct {
-	TripID    string  `json:"tripID"`
-	SessionID string  `json:"sessionID"`
-	Amount    float64 `json:"amount"`
-	Currency  string  `json:"currency"`
+// TripCompletedData is the payload for trip.event.completed
+type TripCompletedData struct {
+	Trip     *trip.Trip `json:"trip"`
+	DriverID string     `json:"driverID"`
 }
 
-type PaymentStatusUpdateData struct {
-	TripID   string `json:"tripID"`
-	UserID   string `json:"userID"`
-	DriverID string `json:"driverID"`
+// TripRatedData is the payload for trip.event.rated
+type TripRatedData struct {
+	Rating    *rating.Rating          `json:"rating"`
+	Aggregate *rating.AggregateRating `json:"aggregate"`
 }
 
-type PaymentSelectCardData struct {
-	TripID string `json:"tripID"`
-	UserID string `json:"userID"`
+type DriverTripResponseData struct {
+	Driver  *driver.Driver     `json:"driver"`
+	TripID  string             `json:"tripID"`
+	RiderID string             `json:"riderID"`
+	Rider   *user.RiderSummary `json:"rider,omitempty"`
 }
+
+// PaymentTripResponseData is the payload for payment.cmd.create_session
+type PaymentTripResponseData = payment.CreateSessionRequest
+
+// PaymentEventSessionCreatedData is the payload for payment.event.session_created
+type PaymentEventSessionCreatedData = payment.SessionCreatedEvent
+
+// PaymentStatusUpdateData is the payload for payment status events such as payment.event.success
+type PaymentStatusUpdateData = payment.PaymentStatusEvent
+
+// PaymentSelectCardData is the payload for payment.cmd.select_card and payment.cmd.select_crypto
+type PaymentSelectCardData = payment.SelectPaymentMethodCommand
+
+// PaymentEventFailedData is the payload for payment.event.failed
+type PaymentEventFailedData = payment.PaymentFailedEvent
+
+// PaymentEventExpiredData is the payload for payment.event.expired
+type PaymentEventExpiredData = payment.PaymentExpiredEvent
+
+// PaymentEventRefundedData is the payload for payment.event.refunded
+type PaymentEventRefundedData = payment.PaymentRefundedEvent
+
+// PaymentEventDisputedData is the payload for payment.event.disputed
+type PaymentEventDisputedData = payment.PaymentDisputedEvent
+
+// NotificationCmdSendData is the payload for notification.cmd.send
+type NotificationCmdSendData = notification.Notification
+
+// NotificationDeliveryStatusData is the payload for notification.event.delivered and notification.event.failed
+type NotificationDeliveryStatusData = notification.DeliveryStatusEvent
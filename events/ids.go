@@ -0,0 +1,70 @@
+package events
+
+import "encoding/json"
+
+// IDs are the business entities an event is about. Fields are empty when the payload does not carry them.
+type IDs struct {
+	TripID   string
+	UserID   string
+	DriverID string
+}
+
+type entityRef struct {
+	ID string `json:"id"`
+}
+
+// idsPayload covers the fields through which the known payloads reference trips, users and drivers.
+type idsPayload struct {
+	Trip *struct {
+		ID     string     `json:"id"`
+		UserID string     `json:"userID"`
+		Driver *entityRef `json:"driver"`
+	} `json:"trip"`
+	Driver *entityRef `json:"driver"`
+	Rating *struct {
+		TripID string `json:"tripID"`
+	} `json:"rating"`
+	TripID   string `json:"tripID"`
+	UserID   string `json:"userID"`
+	RiderID  string `json:"riderID"`
+	DriverID string `json:"driverID"`
+}
+
+// ExtractIDs returns the trip, user and driver IDs referenced by the data of a known event payload.
+func ExtractIDs(data json.RawMessage) IDs {
+	var p idsPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return IDs{}
+	}
+
+	ids := IDs{
+		TripID:   p.TripID,
+		UserID:   firstNonEmpty(p.UserID, p.RiderID),
+		DriverID: p.DriverID,
+	}
+
+	if p.Trip != nil {
+		ids.TripID = firstNonEmpty(ids.TripID, p.Trip.ID)
+		ids.UserID = firstNonEmpty(ids.UserID, p.Trip.UserID)
+		if p.Trip.Driver != nil {
+			ids.DriverID = firstNonEmpty(ids.DriverID, p.Trip.Driver.ID)
+		}
+	}
+	if p.Driver != nil {
+		ids.DriverID = firstNonEmpty(ids.DriverID, p.Driver.ID)
+	}
+	if p.Rating != nil {
+		ids.TripID = firstNonEmpty(ids.TripID, p.Rating.TripID)
+	}
+
+	return ids
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,261 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ride4Low/contracts/pkg/vehicle"
+	"github.com/ride4Low/contracts/proto/driver"
+)
+
+// ErrUnknownWSMessageType is returned when decoding a websocket message of an unknown type.
+var ErrUnknownWSMessageType = errors.New("unknown websocket message type")
+
+// ErrInvalidWSMessage is returned when a websocket message or its data fails validation.
+var ErrInvalidWSMessage = errors.New("invalid websocket message")
+
+// WSMessage is the envelope exchanged over websockets between the API gateway and the rider and driver apps.
+type WSMessage struct {
+	Type string `json:"type"`
+	// RequestID correlates a client message with the gateway's reply or error.
+	RequestID string          `json:"requestID,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Error     *WSError        `json:"error,omitempty"`
+}
+
+// WSError describes why the gateway could not handle a client message.
+type WSError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// WS Events
+const (
+	// Sent by the gateway when a client message cannot be handled
+	WSEventError = "error"
+
+	// DriverWSRegister = "driver.ws.register"
+	DriverCmdRegister = "driver.cmd.register"
+)
+
+// WS error codes
+const (
+	WSErrorInvalidMessage = "invalid_message"
+	WSErrorUnknownType    = "unknown_type"
+	WSErrorInternal       = "internal"
+)
+
+// DriverRegisterData is the websocket payload for driver.cmd.register
+type DriverRegisterData struct {
+	PackageSlug string `json:"packageSlug"`
+}
+
+// DriverLocationData is the websocket payload for driver.cmd.location
+type DriverLocationData = driver.LocationUpdate
+
+type wsMessageSpec struct {
+	// newData returns a pointer to the payload type of the message
+	newData func() any
+	// routingKey is the AMQP routing key the gateway publishes the message with, if any
+	routingKey string
+	validate   func(any) error
+}
+
+var wsMessages = map[string]wsMessageSpec{
+	// Client -> gateway
+	DriverCmdRegister: {
+		newData:  func() any { return &DriverRegisterData{} },
+		validate: validateDriverRegister,
+	},
+	DriverCmdLocation: {
+		newData:    func() any { return &DriverLocationData{} },
+		routingKey: DriverCmdLocation,
+		validate:   validateDriverLocation,
+	},
+	DriverCmdTripAccept: {
+		newData:    func() any { return &DriverTripResponseData{} },
+		routingKey: DriverCmdTripAccept,
+		validate:   validateDriverTripResponse,
+	},
+	DriverCmdTripDecline: {
+		newData:    func() any { return &DriverTripResponseData{} },
+		routingKey: DriverCmdTripDecline,
+		validate:   validateDriverTripResponse,
+	},
+	PaymentCmdSelectCard: {
+		newData:    func() any { return &PaymentSelectCardData{} },
+		routingKey: PaymentCmdSelectCard,
+		validate:   validatePaymentSelect,
+	},
+	PaymentCmdSelectCrypto: {
+		newData:    func() any { return &PaymentSelectCardData{} },
+		routingKey: PaymentCmdSelectCrypto,
+		validate:   validatePaymentSelect,
+	},
+
+	// Gateway -> client
+	TripEventScheduled:         {newData: func() any { return &TripScheduledData{} }},
+	TripEventCreated:           {newData: func() any { return &TripEventData{} }},
+	TripEventNoDriversFound:    {newData: func() any { return &TripEventData{} }},
+	TripEventDriverAssigned:    {newData: func() any { return &TripEventData{} }},
+	TripEventCancelled:         {newData: func() any { return &TripCancelledData{} }},
+	TripEventCompleted:         {newData: func() any { return &TripCompletedData{} }},
+	DriverCmdTripRequest:       {newData: func() any { return &TripEventData{} }},
+	PaymentEventSessionCreated: {newData: func() any { return &PaymentEventSessionCreatedData{} }},
+	PaymentEventSuccess:        {newData: func() any { return &PaymentStatusUpdateData{} }},
+	PaymentEventFailed:         {newData: func() any { return &PaymentEventFailedData{} }},
+	PaymentEventExpired:        {newData: func() any { return &PaymentEventExpiredData{} }},
+	PaymentEventRefunded:       {newData: func() any { return &PaymentEventRefundedData{} }},
+	PaymentEventDisputed:       {newData: func() any { return &PaymentEventDisputedData{} }},
+	WSEventError:               {newData: func() any { return nil }},
+}
+
+// WSRoutingKey returns the AMQP routing key the gateway publishes a client message of the given type with.
+// It reports false for messages that are not forwarded to RabbitMQ.
+func WSRoutingKey(msgType string) (string, bool) {
+	spec, ok := wsMessages[msgType]
+	if !ok || spec.routingKey == "" {
+		return "", false
+	}
+
+	return spec.routingKey, true
+}
+
+// NewWSMessage creates a websocket message with data encoded as its payload.
+func NewWSMessage(msgType, requestID string, data any) (*WSMessage, error) {
+	if _, ok := wsMessages[msgType]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownWSMessageType, msgType)
+	}
+
+	msg := &WSMessage{
+		Type:      msgType,
+		RequestID: requestID,
+	}
+
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal websocket data: %w", err)
+		}
+		msg.Data = raw
+	}
+
+	return msg, nil
+}
+
+// NewWSErrorMessage creates an error reply to the client message with the given request ID.
+func NewWSErrorMessage(requestID, code, message string) *WSMessage {
+	return &WSMessage{
+		Type:      WSEventError,
+		RequestID: requestID,
+		Error: &WSError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
+
+// EncodeWSMessage encodes a websocket message with data as its payload.
+func EncodeWSMessage(msgType, requestID string, data any) ([]byte, error) {
+	msg, err := NewWSMessage(msgType, requestID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(msg)
+}
+
+// DecodeWSMessage decodes a websocket message and its payload, validating both.
+// The returned data is a pointer to the payload type of the message, e.g. *DriverTripResponseData.
+func DecodeWSMessage(raw []byte) (*WSMessage, any, error) {
+	var msg WSMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidWSMessage, err)
+	}
+
+	if msg.Type == "" {
+		return &msg, nil, fmt.Errorf("%w: type is required", ErrInvalidWSMessage)
+	}
+
+	spec, ok := wsMessages[msg.Type]
+	if !ok {
+		return &msg, nil, fmt.Errorf("%w: %q", ErrUnknownWSMessageType, msg.Type)
+	}
+
+	data := spec.newData()
+	if data == nil {
+		return &msg, nil, nil
+	}
+
+	if len(msg.Data) == 0 {
+		return &msg, nil, fmt.Errorf("%w: %s: data is required", ErrInvalidWSMessage, msg.Type)
+	}
+
+	if err := json.Unmarshal(msg.Data, data); err != nil {
+		return &msg, nil, fmt.Errorf("%w: %s: %v", ErrInvalidWSMessage, msg.Type, err)
+	}
+
+	if spec.validate != nil {
+		if err := spec.validate(data); err != nil {
+			return &msg, nil, fmt.Errorf("%w: %s: %w", ErrInvalidWSMessage, msg.Type, err)
+		}
+	}
+
+	return &msg, data, nil
+}
+
+func validateDriverRegister(data any) error {
+	d := data.(*DriverRegisterData)
+	_, err := vehicle.Parse(d.PackageSlug)
+	return err
+}
+
+func validateDriverLocation(data any) error {
+	d := data.(*DriverLocationData)
+	location := d.GetLocation()
+	if location == nil {
+		return errors.New("location is required")
+	}
+
+	var errs []error
+	if lat := location.GetLatitude(); lat < -90 || lat > 90 {
+		errs = append(errs, fmt.Errorf("latitude %v out of range", lat))
+	}
+	if lng := location.GetLongitude(); lng < -180 || lng > 180 {
+		errs = append(errs, fmt.Errorf("longitude %v out of range", lng))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateDriverTripResponse(data any) error {
+	d := data.(*DriverTripResponseData)
+
+	var errs []error
+	if d.TripID == "" {
+		errs = append(errs, errors.New("tripID is required"))
+	}
+	if d.RiderID == "" {
+		errs = append(errs, errors.New("riderID is required"))
+	}
+	if d.Driver == nil {
+		errs = append(errs, errors.New("driver is required"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validatePaymentSelect(data any) error {
+	d := data.(*PaymentSelectCardData)
+
+	var errs []error
+	if d.GetTripID() == "" {
+		errs = append(errs, errors.New("tripID is required"))
+	}
+	if d.GetUserID() == "" {
+		errs = append(errs, errors.New("userID is required"))
+	}
+
+	return errors.Join(errs...)
+}
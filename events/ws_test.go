@@ -0,0 +1,152 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ride4Low/contracts/proto/driver"
+	"github.com/ride4Low/contracts/proto/trip"
+)
+
+// Drivers send the package slug as a string, as they did before the vehiclePackage enum was added.
+func TestDecodeWSMessageWithLegacyPackageSlug(t *testing.T) {
+	raw := []byte(`{"type":"driver.cmd.trip_accept","data":{"tripID":"trip-1","riderID":"user-1","driver":{"id":"driver-1","packageSlug":"sedan"}}}`)
+
+	_, data, err := DecodeWSMessage(raw)
+	if err != nil {
+		t.Fatalf("DecodeWSMessage() error = %v", err)
+	}
+
+	resp, ok := data.(*DriverTripResponseData)
+	if !ok {
+		t.Fatalf("data = %T, want *DriverTripResponseData", data)
+	}
+	if got := resp.Driver.GetPackageSlug(); got != "sedan" {
+		t.Errorf("packageSlug = %q, want \"sedan\"", got)
+	}
+}
+
+func TestDecodeWSMessageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want error
+	}{
+		{name: "malformed JSON", raw: `{"type":`, want: ErrInvalidWSMessage},
+		{name: "missing type", raw: `{"data":{}}`, want: ErrInvalidWSMessage},
+		{name: "unknown type", raw: `{"type":"driver.cmd.teleport","data":{}}`, want: ErrUnknownWSMessageType},
+		{name: "missing data", raw: `{"type":"driver.cmd.location"}`, want: ErrInvalidWSMessage},
+		{name: "malformed data", raw: `{"type":"driver.cmd.location","data":[]}`, want: ErrInvalidWSMessage},
+		{name: "unknown package", raw: `{"type":"driver.cmd.register","data":{"packageSlug":"bike"}}`, want: ErrInvalidWSMessage},
+		{name: "missing location", raw: `{"type":"driver.cmd.location","data":{"heading":90}}`, want: ErrInvalidWSMessage},
+		{name: "latitude out of range", raw: `{"type":"driver.cmd.location","data":{"location":{"latitude":91,"longitude":0}}}`, want: ErrInvalidWSMessage},
+		{name: "longitude out of range", raw: `{"type":"driver.cmd.location","data":{"location":{"latitude":0,"longitude":-181}}}`, want: ErrInvalidWSMessage},
+		{name: "missing tripID", raw: `{"type":"driver.cmd.trip_accept","data":{"riderID":"user-1","driver":{"id":"driver-1"}}}`, want: ErrInvalidWSMessage},
+		{name: "missing riderID", raw: `{"type":"driver.cmd.trip_decline","data":{"tripID":"trip-1","driver":{"id":"driver-1"}}}`, want: ErrInvalidWSMessage},
+		{name: "missing driver", raw: `{"type":"driver.cmd.trip_accept","data":{"tripID":"trip-1","riderID":"user-1"}}`, want: ErrInvalidWSMessage},
+		{name: "payment without tripID", raw: `{"type":"payment.cmd.select_card","data":{"userID":"user-1"}}`, want: ErrInvalidWSMessage},
+		{name: "payment without userID", raw: `{"type":"payment.cmd.select_crypto","data":{"tripID":"trip-1"}}`, want: ErrInvalidWSMessage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, data, err := DecodeWSMessage([]byte(tt.raw))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("DecodeWSMessage() error = %v, want %v", err, tt.want)
+			}
+			if data != nil {
+				t.Errorf("data = %v, want nil", data)
+			}
+		})
+	}
+}
+
+func TestDecodeWSMessageValid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "register", raw: `{"type":"driver.cmd.register","data":{"packageSlug":"sedan"}}`},
+		{name: "location", raw: `{"type":"driver.cmd.location","data":{"location":{"latitude":90,"longitude":-180}}}`},
+		{name: "payment", raw: `{"type":"payment.cmd.select_card","data":{"tripID":"trip-1","userID":"user-1"}}`},
+		{name: "error without data", raw: `{"type":"error","requestID":"req-1","error":{"code":"internal","message":"boom"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeWSMessage([]byte(tt.raw)); err != nil {
+				t.Errorf("DecodeWSMessage() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWSRoutingKey(t *testing.T) {
+	tests := []struct {
+		msgType string
+		want    string
+		wantOK  bool
+	}{
+		{msgType: DriverCmdLocation, want: DriverCmdLocation, wantOK: true},
+		{msgType: DriverCmdTripAccept, want: DriverCmdTripAccept, wantOK: true},
+		{msgType: PaymentCmdSelectCrypto, want: PaymentCmdSelectCrypto, wantOK: true},
+		{msgType: DriverCmdRegister, wantOK: false},
+		{msgType: TripEventCreated, wantOK: false},
+		{msgType: "driver.cmd.teleport", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := WSRoutingKey(tt.msgType)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("WSRoutingKey(%q) = %q, %v, want %q, %v", tt.msgType, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestWSMessageRoundTrip(t *testing.T) {
+	tests := []struct {
+		msgType string
+		data    any
+	}{
+		{msgType: DriverCmdLocation, data: &DriverLocationData{Sequence: 7, Location: &driver.Location{Latitude: 52.5, Longitude: 13.4}, Heading: 90}},
+		{msgType: DriverCmdTripAccept, data: &DriverTripResponseData{TripID: "trip-1", RiderID: "user-1", Driver: &driver.Driver{Id: "driver-1"}}},
+		{msgType: TripEventScheduled, data: &TripScheduledData{Trip: &trip.Trip{Id: "trip-1"}, ScheduledPickupTime: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}},
+		{msgType: PaymentEventDisputed, data: &PaymentEventDisputedData{TripID: "trip-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msgType, func(t *testing.T) {
+			raw, err := EncodeWSMessage(tt.msgType, "req-1", tt.data)
+			if err != nil {
+				t.Fatalf("EncodeWSMessage() error = %v", err)
+			}
+
+			msg, data, err := DecodeWSMessage(raw)
+			if err != nil {
+				t.Fatalf("DecodeWSMessage() error = %v", err)
+			}
+			if msg.Type != tt.msgType || msg.RequestID != "req-1" {
+				t.Errorf("DecodeWSMessage() = %q, %q, want %q, %q", msg.Type, msg.RequestID, tt.msgType, "req-1")
+			}
+			if reflect.TypeOf(data) != reflect.TypeOf(tt.data) {
+				t.Fatalf("data = %T, want %T", data, tt.data)
+			}
+
+			// Protobuf messages are compared by re-encoding, as they hold internal state
+			want, _ := json.Marshal(tt.data)
+			got, _ := json.Marshal(data)
+			if string(got) != string(want) {
+				t.Errorf("data = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodeWSMessageUnknownType(t *testing.T) {
+	if _, err := EncodeWSMessage("driver.cmd.teleport", "", nil); !errors.Is(err, ErrUnknownWSMessageType) {
+		t.Errorf("EncodeWSMessage() error = %v, want %v", err, ErrUnknownWSMessageType)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Package locationstream provides a client for DriverService.StreamLocations that batches
+location updates and resumes the stream after disconnects.
+*/
+package locationstream
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/ride4Low/contracts/proto/driver"
+)
+
+// Config holds the configuration for a location stream Client.
+type Config struct {
+	// DriverID is the driver whose locations are streamed.
+	DriverID string
+	// MaxBatchSize is the maximum number of updates sent in a single LocationBatch.
+	MaxBatchSize int
+	// FlushInterval is how often buffered updates are sent.
+	FlushInterval time.Duration
+	// MaxBuffered is the maximum number of unacknowledged updates kept; the oldest are dropped first.
+	MaxBuffered int
+	// MaxReconnectInterval caps the backoff between reconnection attempts.
+	MaxReconnectInterval time.Duration
+	// Logger reports disconnections. Defaults to slog.Default().
+	Logger *slog.Logger
+}
+
+// DefaultConfig returns a default configuration for the given driver.
+func DefaultConfig(driverID string) Config {
+	return Config{
+		DriverID:             driverID,
+		MaxBatchSize:         20,
+		FlushInterval:        time.Second,
+		MaxBuffered:          1000,
+		MaxReconnectInterval: 30 * time.Second,
+		Logger:               slog.Default(),
+	}
+}
+
+// Client streams location updates to the driver service.
+// Updates are kept until the server acknowledges them and are re-sent after a reconnect.
+type Client struct {
+	client driver.DriverServiceClient
+	cfg    Config
+	wake   chan struct{}
+
+	mu           sync.Mutex
+	pending      []*driver.LocationUpdate
+	nextSequence uint64
+	batchSize    int
+	interval     time.Duration
+}
+
+// NewClient creates a new location stream client.
+// Zero values in cfg are replaced with those of DefaultConfig.
+func NewClient(client driver.DriverServiceClient, cfg Config) *Client {
+	defaults := DefaultConfig(cfg.DriverID)
+	if cfg.MaxBatchSize <= 0 {
+		cfg.MaxBatchSize = defaults.MaxBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaults.FlushInterval
+	}
+	if cfg.MaxBuffered <= 0 {
+		cfg.MaxBuffered = defaults.MaxBuffered
+	}
+	if cfg.MaxReconnectInterval <= 0 {
+		cfg.MaxReconnectInterval = defaults.MaxReconnectInterval
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = defaults.Logger
+	}
+
+	return &Client{
+		client: client,
+		cfg:    cfg,
+		wake:   make(chan struct{}, 1),
+		// Sequences start from the clock so that they keep increasing after the app restarts
+		nextSequence: uint64(time.Now().UnixNano()),
+		batchSize:    cfg.MaxBatchSize,
+		interval:     cfg.FlushInterval,
+	}
+}
+
+// Send buffers an update and assigns its sequence number. It never blocks.
+func (c *Client) Send(update *driver.LocationUpdate) {
+	c.mu.Lock()
+	c.nextSequence++
+	update.Sequence = c.nextSequence
+	c.pending = append(c.pending, update)
+	if dropped := len(c.pending) - c.cfg.MaxBuffered; dropped > 0 {
+		c.pending = append([]*driver.LocationUpdate(nil), c.pending[dropped:]...)
+	}
+	full := len(c.pending) >= c.batchSize
+	c.mu.Unlock()
+
+	if full {
+		select {
+		case c.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run streams buffered updates until ctx is cancelled, reconnecting with exponential backoff.
+func (c *Client) Run(ctx context.Context) error {
+	b := backoff.NewExponentialBackOff()
+	b.MaxInterval = c.cfg.MaxReconnectInterval
+
+	for {
+		acked, err := c.stream(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if acked {
+			b.Reset()
+		}
+
+		wait := b.NextBackOff()
+		c.cfg.Logger.WarnContext(ctx, "Location stream disconnected",
+			"driver_id", c.cfg.DriverID,
+			"reconnect_in", wait,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// stream runs a single StreamLocations call and reports whether the server acknowledged any update.
+func (c *Client) stream(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.StreamLocations(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		ackMu sync.Mutex
+		acked bool
+	)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			c.ack(ack)
+			ackMu.Lock()
+			acked = true
+			ackMu.Unlock()
+		}
+	}()
+
+	hasAcked := func() bool {
+		ackMu.Lock()
+		defer ackMu.Unlock()
+		return acked
+	}
+
+	// Everything still pending was not acknowledged on the previous stream, so it is re-sent
+	var sent uint64
+	var lastSend time.Time
+	timer := time.NewTimer(c.flushInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = stream.CloseSend()
+			return hasAcked(), ctx.Err()
+		case err := <-recvErr:
+			return hasAcked(), err
+		case <-timer.C:
+		case <-c.wake:
+			if time.Since(lastSend) < c.flushInterval() {
+				continue
+			}
+		}
+
+		for {
+			batch, full := c.nextBatch(sent)
+			if len(batch) == 0 {
+				break
+			}
+			if err := stream.Send(&driver.LocationBatch{
+				DriverID: c.cfg.DriverID,
+				Updates:  batch,
+			}); err != nil {
+				return hasAcked(), err
+			}
+			sent = batch[len(batch)-1].GetSequence()
+			lastSend = time.Now()
+			if !full {
+				break
+			}
+		}
+
+		timer.Reset(c.flushInterval())
+	}
+}
+
+// nextBatch returns the pending updates with a sequence greater than after, and whether the batch is full.
+func (c *Client) nextBatch(after uint64) ([]*driver.LocationUpdate, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	batch := make([]*driver.LocationUpdate, 0, c.batchSize)
+	for _, update := range c.pending {
+		if update.GetSequence() <= after {
+			continue
+		}
+		if len(batch) == c.batchSize {
+			return batch, true
+		}
+		batch = append(batch, update)
+	}
+
+	return batch, false
+}
+
+// ack drops acknowledged updates and applies the server's backpressure hints.
+func (c *Client) ack(ack *driver.LocationAck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := 0
+	for i < len(c.pending) && c.pending[i].GetSequence() <= ack.GetSequence() {
+		i++
+	}
+	c.pending = c.pending[i:]
+
+	c.interval = c.cfg.FlushInterval
+	if hint := ack.GetMinSendInterval().AsDuration(); hint > c.interval {
+		c.interval = hint
+	}
+
+	c.batchSize = c.cfg.MaxBatchSize
+	if hint := int(ack.GetMaxBatchSize()); hint > 0 && hint < c.batchSize {
+		c.batchSize = hint
+	}
+}
+
+func (c *Client) flushInterval() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interval
+}
@@ -0,0 +1,252 @@
+package locationstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ride4Low/contracts/proto/driver"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeClient hands every StreamLocations call a fakeStream, published on streams.
+type fakeClient struct {
+	driver.DriverServiceClient
+	streams chan *fakeStream
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{streams: make(chan *fakeStream, 10)}
+}
+
+func (c *fakeClient) StreamLocations(ctx context.Context, _ ...grpc.CallOption) (grpc.BidiStreamingClient[driver.LocationBatch, driver.LocationAck], error) {
+	s := &fakeStream{
+		ctx:  ctx,
+		sent: make(chan *driver.LocationBatch, 100),
+		recv: make(chan recvResult, 10),
+	}
+	c.streams <- s
+	return s, nil
+}
+
+type recvResult struct {
+	ack *driver.LocationAck
+	err error
+}
+
+// fakeStream records sent batches and returns the acks and errors queued on recv.
+type fakeStream struct {
+	grpc.ClientStream
+	ctx  context.Context
+	sent chan *driver.LocationBatch
+	recv chan recvResult
+}
+
+func (s *fakeStream) Send(batch *driver.LocationBatch) error {
+	s.sent <- batch
+	return nil
+}
+
+func (s *fakeStream) Recv() (*driver.LocationAck, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case r := <-s.recv:
+		return r.ack, r.err
+	}
+}
+
+func (s *fakeStream) CloseSend() error { return nil }
+
+func testConfig() Config {
+	return Config{
+		DriverID:             "driver-1",
+		MaxBatchSize:         2,
+		FlushInterval:        10 * time.Millisecond,
+		MaxBuffered:          100,
+		MaxReconnectInterval: 10 * time.Millisecond,
+		Logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out")
+		var zero T
+		return zero
+	}
+}
+
+func sequences(updates []*driver.LocationUpdate) []uint64 {
+	seqs := make([]uint64, len(updates))
+	for i, update := range updates {
+		seqs[i] = update.GetSequence()
+	}
+	return seqs
+}
+
+func sendUpdates(c *Client, n int) []uint64 {
+	seqs := make([]uint64, n)
+	for i := range n {
+		update := &driver.LocationUpdate{Location: &driver.Location{Latitude: float64(i)}}
+		c.Send(update)
+		seqs[i] = update.GetSequence()
+	}
+	return seqs
+}
+
+func TestSendAssignsIncreasingSequences(t *testing.T) {
+	seqs := sendUpdates(NewClient(nil, testConfig()), 3)
+	for i := 1; i < len(seqs); i++ {
+		if seqs[i] <= seqs[i-1] {
+			t.Fatalf("sequences %v are not increasing", seqs)
+		}
+	}
+}
+
+func TestRunSendsBatchesOfMaxBatchSize(t *testing.T) {
+	fc := newFakeClient()
+	c := NewClient(fc, testConfig())
+	seqs := sendUpdates(c, 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Run(ctx)
+
+	s := receive(t, fc.streams)
+	var got [][]uint64
+	for range 3 {
+		batch := receive(t, s.sent)
+		if batch.GetDriverID() != "driver-1" {
+			t.Errorf("DriverID = %q, want %q", batch.GetDriverID(), "driver-1")
+		}
+		got = append(got, sequences(batch.GetUpdates()))
+	}
+
+	want := [][]uint64{seqs[0:2], seqs[2:4], seqs[4:5]}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("batch %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRunResendsPendingAfterRecvError(t *testing.T) {
+	fc := newFakeClient()
+	c := NewClient(fc, testConfig())
+	seqs := sendUpdates(c, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Run(ctx)
+
+	first := receive(t, fc.streams)
+	if got := sequences(receive(t, first.sent).GetUpdates()); !slices.Equal(got, seqs) {
+		t.Fatalf("first stream batch = %v, want %v", got, seqs)
+	}
+	first.recv <- recvResult{err: errors.New("connection reset")}
+
+	// Nothing was acknowledged, so the reconnected stream starts over
+	second := receive(t, fc.streams)
+	if got := sequences(receive(t, second.sent).GetUpdates()); !slices.Equal(got, seqs) {
+		t.Fatalf("second stream batch = %v, want %v", got, seqs)
+	}
+
+	second.recv <- recvResult{ack: &driver.LocationAck{Sequence: seqs[1]}}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if batch, _ := c.nextBatch(0); len(batch) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("acknowledged updates are still pending")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAckDropsAcknowledgedUpdates(t *testing.T) {
+	c := NewClient(nil, testConfig())
+	seqs := sendUpdates(c, 3)
+
+	c.ack(&driver.LocationAck{Sequence: seqs[1]})
+
+	if got := sequences(c.pending); !slices.Equal(got, seqs[2:]) {
+		t.Errorf("pending = %v, want %v", got, seqs[2:])
+	}
+
+	// Stale acknowledgements change nothing
+	c.ack(&driver.LocationAck{Sequence: seqs[0]})
+	if got := sequences(c.pending); !slices.Equal(got, seqs[2:]) {
+		t.Errorf("pending = %v, want %v", got, seqs[2:])
+	}
+}
+
+func TestAckAppliesBackpressureHints(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxBatchSize = 3
+
+	tests := []struct {
+		name         string
+		ack          *driver.LocationAck
+		wantInterval time.Duration
+		wantBatch    int
+	}{
+		{
+			name:         "slower and smaller",
+			ack:          &driver.LocationAck{MinSendInterval: durationpb.New(time.Second), MaxBatchSize: 1},
+			wantInterval: time.Second,
+			wantBatch:    1,
+		},
+		{
+			name:         "no hints",
+			ack:          &driver.LocationAck{},
+			wantInterval: cfg.FlushInterval,
+			wantBatch:    3,
+		},
+		{
+			name:         "hints beyond the configuration are ignored",
+			ack:          &driver.LocationAck{MinSendInterval: durationpb.New(time.Millisecond), MaxBatchSize: 10},
+			wantInterval: cfg.FlushInterval,
+			wantBatch:    3,
+		},
+	}
+
+	c := NewClient(nil, cfg)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.pending = nil
+			sendUpdates(c, 5)
+			c.ack(tt.ack)
+
+			if got := c.flushInterval(); got != tt.wantInterval {
+				t.Errorf("flushInterval() = %v, want %v", got, tt.wantInterval)
+			}
+			if batch, full := c.nextBatch(0); len(batch) != tt.wantBatch || !full {
+				t.Errorf("nextBatch() = %d updates, full %v, want %d, true", len(batch), full, tt.wantBatch)
+			}
+		})
+	}
+}
+
+func TestSendDropsOldestWhenBufferIsFull(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxBuffered = 3
+	c := NewClient(nil, cfg)
+
+	seqs := sendUpdates(c, 5)
+
+	if got := sequences(c.pending); !slices.Equal(got, seqs[2:]) {
+		t.Errorf("pending = %v, want %v", got, seqs[2:])
+	}
+}
@@ -0,0 +1,92 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Baggage members identifying the business entities a request is about.
+const (
+	BaggageTripID   = "tripID"
+	BaggageUserID   = "userID"
+	BaggageDriverID = "driverID"
+)
+
+// DefaultBaggageAttributes are the baggage members copied onto spans by default.
+var DefaultBaggageAttributes = []string{BaggageTripID, BaggageUserID, BaggageDriverID}
+
+// WithTripID puts the trip ID into the context's baggage.
+func WithTripID(ctx context.Context, tripID string) context.Context {
+	return WithBaggage(ctx, BaggageTripID, tripID)
+}
+
+// WithUserID puts the user ID into the context's baggage.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return WithBaggage(ctx, BaggageUserID, userID)
+}
+
+// WithDriverID puts the driver ID into the context's baggage.
+func WithDriverID(ctx context.Context, driverID string) context.Context {
+	return WithBaggage(ctx, BaggageDriverID, driverID)
+}
+
+// WithBaggage puts a member into the context's baggage, so that it is propagated to downstream services,
+// and sets it on the context's span, which started before the member was known.
+// Empty values are ignored.
+func WithBaggage(ctx context.Context, key, value string) context.Context {
+	if value == "" {
+		return ctx
+	}
+
+	member, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		otel.Handle(err)
+		return ctx
+	}
+
+	b, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		otel.Handle(err)
+		return ctx
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(key, value))
+
+	return baggage.ContextWithBaggage(ctx, b)
+}
+
+// BaggageValue returns the value of a baggage member, or "" when it is not set.
+func BaggageValue(ctx context.Context, key string) string {
+	return baggage.FromContext(ctx).Member(key).Value()
+}
+
+// NewBaggageSpanProcessor returns a span processor that copies the given baggage members onto spans as attributes.
+// Setup adds one for Config.BaggageAttributes.
+func NewBaggageSpanProcessor(keys ...string) sdktrace.SpanProcessor {
+	return baggageSpanProcessor{keys: keys}
+}
+
+// baggageSpanProcessor copies the selected baggage members onto spans as attributes when they start.
+type baggageSpanProcessor struct {
+	keys []string
+}
+
+func (p baggageSpanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	b := baggage.FromContext(parent)
+	for _, key := range p.keys {
+		if member := b.Member(key); member.Key() != "" {
+			s.SetAttributes(attribute.String(key, member.Value()))
+		}
+	}
+}
+
+func (p baggageSpanProcessor) OnEnd(sdktrace.ReadOnlySpan) {}
+
+func (p baggageSpanProcessor) Shutdown(context.Context) error { return nil }
+
+func (p baggageSpanProcessor) ForceFlush(context.Context) error { return nil }
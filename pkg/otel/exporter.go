@@ -0,0 +1,329 @@
+package otel
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ride4Low/contracts/env"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+// Exporters
+const (
+	// ExporterOTLPGRPC exports to an OTLP collector over gRPC.
+	ExporterOTLPGRPC = "otlp-grpc"
+	// ExporterOTLPHTTP exports to an OTLP collector over HTTP/protobuf.
+	ExporterOTLPHTTP = "otlp-http"
+	// ExporterStdout pretty-prints telemetry to stdout, for local development.
+	ExporterStdout = "stdout"
+	// ExporterNone drops telemetry.
+	ExporterNone = "none"
+)
+
+// CompressionGzip compresses OTLP payloads with gzip.
+const CompressionGzip = "gzip"
+
+// exporterOptions are the OTLP settings shared by every signal.
+type exporterOptions struct {
+	// endpoint is either host:port or a URL, as told by endpointURL
+	endpoint    string
+	endpointURL bool
+	insecure    bool
+	headers     map[string]string
+	gzip        bool
+	tlsConfig   *tls.Config
+}
+
+func newExporterOptions(cfg Config, endpoint string) (exporterOptions, error) {
+	endpointURL := strings.Contains(endpoint, "://")
+
+	opts := exporterOptions{
+		endpoint:    endpoint,
+		endpointURL: endpointURL,
+		// The scheme of an endpoint URL decides whether TLS is used
+		insecure: cfg.Insecure && !endpointURL,
+		headers:  cfg.Headers,
+	}
+
+	switch cfg.Compression {
+	case "", "none":
+	case CompressionGzip:
+		opts.gzip = true
+	default:
+		return exporterOptions{}, fmt.Errorf("unknown compression: %q", cfg.Compression)
+	}
+
+	if !opts.insecure {
+		tlsConfig, err := loadTLSConfig(cfg.TLS)
+		if err != nil {
+			return exporterOptions{}, err
+		}
+		opts.tlsConfig = tlsConfig
+	}
+
+	return opts, nil
+}
+
+// loadTLSConfig returns nil when no certificate is configured, leaving the exporters to use the system roots.
+func loadTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.CAFile == "" && cfg.CertFile == "" && cfg.KeyFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CAFile != "" {
+		ca, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// traceEndpoint falls back to the deprecated JaegerEndpoint, which only accepts traces.
+func (c Config) traceEndpoint() string {
+	if c.OTLPEndpoint != "" {
+		return c.OTLPEndpoint
+	}
+	return c.JaegerEndpoint
+}
+
+// newTraceExporter returns nil when traces are not exported.
+func newTraceExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	o, err := newExporterOptions(cfg, cfg.traceEndpoint())
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Exporter {
+	case "", ExporterOTLPGRPC:
+		var opts []otlptracegrpc.Option
+		if o.endpointURL {
+			opts = append(opts, otlptracegrpc.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(o.tlsConfig)))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlptracegrpc.WithCompressor(CompressionGzip))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		var opts []otlptracehttp.Option
+		if o.endpointURL {
+			opts = append(opts, otlptracehttp.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(o.tlsConfig))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter: %q", cfg.Exporter)
+	}
+}
+
+// newMetricExporter returns nil when metrics are not exported.
+func newMetricExporter(ctx context.Context, cfg Config) (sdkmetric.Exporter, error) {
+	o, err := newExporterOptions(cfg, cfg.OTLPEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Exporter {
+	case "", ExporterOTLPGRPC:
+		var opts []otlpmetricgrpc.Option
+		if o.endpointURL {
+			opts = append(opts, otlpmetricgrpc.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlpmetricgrpc.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(o.tlsConfig)))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlpmetricgrpc.WithCompressor(CompressionGzip))
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		var opts []otlpmetrichttp.Option
+		if o.endpointURL {
+			opts = append(opts, otlpmetrichttp.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlpmetrichttp.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(o.tlsConfig))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	case ExporterStdout:
+		return stdoutmetric.New(stdoutmetric.WithPrettyPrint())
+	case ExporterNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter: %q", cfg.Exporter)
+	}
+}
+
+// newLogExporter returns nil when logs are not exported.
+func newLogExporter(ctx context.Context, cfg Config) (sdklog.Exporter, error) {
+	o, err := newExporterOptions(cfg, cfg.OTLPEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Exporter {
+	case "", ExporterOTLPGRPC:
+		var opts []otlploggrpc.Option
+		if o.endpointURL {
+			opts = append(opts, otlploggrpc.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlploggrpc.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlploggrpc.WithTLSCredentials(credentials.NewTLS(o.tlsConfig)))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlploggrpc.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlploggrpc.WithCompressor(CompressionGzip))
+		}
+		return otlploggrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		var opts []otlploghttp.Option
+		if o.endpointURL {
+			opts = append(opts, otlploghttp.WithEndpointURL(o.endpoint))
+		} else if o.endpoint != "" {
+			opts = append(opts, otlploghttp.WithEndpoint(o.endpoint))
+		}
+		if o.insecure {
+			opts = append(opts, otlploghttp.WithInsecure())
+		}
+		if o.tlsConfig != nil {
+			opts = append(opts, otlploghttp.WithTLSClientConfig(o.tlsConfig))
+		}
+		if len(o.headers) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(o.headers))
+		}
+		if o.gzip {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+		}
+		return otlploghttp.New(ctx, opts...)
+	case ExporterStdout:
+		return stdoutlog.New(stdoutlog.WithPrettyPrint())
+	case ExporterNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter: %q", cfg.Exporter)
+	}
+}
+
+// exporterFromEnv returns the exporter selected by OTEL_TRACES_EXPORTER and OTEL_EXPORTER_OTLP_PROTOCOL.
+func exporterFromEnv() string {
+	switch env.GetString("OTEL_TRACES_EXPORTER", "otlp") {
+	case "none":
+		return ExporterNone
+	case "console":
+		return ExporterStdout
+	}
+
+	if env.GetString("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc") == "http/protobuf" {
+		return ExporterOTLPHTTP
+	}
+
+	return ExporterOTLPGRPC
+}
+
+// parseHeaders parses headers in the OTEL_EXPORTER_OTLP_HEADERS format: "key1=value1,key2=value2",
+// with URL encoded values.
+func parseHeaders(s string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if decoded, err := url.QueryUnescape(value); err == nil {
+			value = decoded
+		}
+		headers[key] = value
+	}
+
+	return headers
+}
@@ -0,0 +1,184 @@
+package otel
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefaultConfigEndpoints(t *testing.T) {
+	setenv(t, "OTEL_EXPORTER_OTLP_ENDPOINT", "")
+
+	cfg := DefaultConfig("test")
+	if cfg.OTLPEndpoint != "" {
+		t.Errorf("OTLPEndpoint = %q, want empty", cfg.OTLPEndpoint)
+	}
+	if got := cfg.traceEndpoint(); got != "jaeger:4317" {
+		t.Errorf("traceEndpoint() = %q, want %q", got, "jaeger:4317")
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "collector:4317")
+	cfg = DefaultConfig("test")
+	if cfg.OTLPEndpoint != "collector:4317" {
+		t.Errorf("OTLPEndpoint = %q, want %q", cfg.OTLPEndpoint, "collector:4317")
+	}
+	if got := cfg.traceEndpoint(); got != "collector:4317" {
+		t.Errorf("traceEndpoint() = %q, want %q", got, "collector:4317")
+	}
+}
+
+func TestTraceEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{name: "otlp", cfg: Config{OTLPEndpoint: "collector:4317", JaegerEndpoint: "jaeger:4317"}, want: "collector:4317"},
+		{name: "jaeger fallback", cfg: Config{JaegerEndpoint: "jaeger:4317"}, want: "jaeger:4317"},
+		{name: "environment", cfg: Config{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.traceEndpoint(); got != tt.want {
+				t.Errorf("traceEndpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	tests := []struct {
+		in   string
+		want map[string]string
+	}{
+		{in: "", want: map[string]string{}},
+		{in: "api-key=secret", want: map[string]string{"api-key": "secret"}},
+		{in: " a = 1 , b=2", want: map[string]string{"a": "1", "b": "2"}},
+		{in: "Authorization=Bearer%20token,x-tenant=a%2Cb", want: map[string]string{"Authorization": "Bearer token", "x-tenant": "a,b"}},
+		{in: "token=abc%3D%3D", want: map[string]string{"token": "abc=="}},
+		{in: "malformed,=empty-key,ok=1", want: map[string]string{"ok": "1"}},
+		{in: "bad=%zz", want: map[string]string{"bad": "%zz"}},
+	}
+
+	for _, tt := range tests {
+		if got := parseHeaders(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseHeaders(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestExporterFromEnv(t *testing.T) {
+	tests := []struct {
+		exporter string
+		protocol string
+		want     string
+	}{
+		{want: ExporterOTLPGRPC},
+		{exporter: "otlp", protocol: "grpc", want: ExporterOTLPGRPC},
+		{exporter: "otlp", protocol: "http/protobuf", want: ExporterOTLPHTTP},
+		{protocol: "http/protobuf", want: ExporterOTLPHTTP},
+		{exporter: "console", want: ExporterStdout},
+		{exporter: "none", protocol: "http/protobuf", want: ExporterNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.exporter+"/"+tt.protocol, func(t *testing.T) {
+			setenv(t, "OTEL_TRACES_EXPORTER", tt.exporter)
+			setenv(t, "OTEL_EXPORTER_OTLP_PROTOCOL", tt.protocol)
+
+			if got := exporterFromEnv(); got != tt.want {
+				t.Errorf("exporterFromEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewExporterOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    exporterOptions
+		wantErr string
+	}{
+		{
+			name: "insecure host:port",
+			cfg:  Config{Insecure: true},
+			want: exporterOptions{endpoint: "collector:4317", insecure: true},
+		},
+		{
+			name: "gzip",
+			cfg:  Config{Insecure: true, Compression: CompressionGzip},
+			want: exporterOptions{endpoint: "collector:4317", insecure: true, gzip: true},
+		},
+		{
+			name: "no compression",
+			cfg:  Config{Insecure: true, Compression: "none"},
+			want: exporterOptions{endpoint: "collector:4317", insecure: true},
+		},
+		{
+			name:    "unknown compression",
+			cfg:     Config{Insecure: true, Compression: "brotli"},
+			wantErr: `unknown compression: "brotli"`,
+		},
+		{
+			name:    "missing CA certificate",
+			cfg:     Config{TLS: TLSConfig{CAFile: "testdata/missing.pem"}},
+			wantErr: "failed to read CA certificate",
+		},
+		{
+			name:    "malformed CA certificate",
+			cfg:     Config{TLS: TLSConfig{CAFile: "exporter_test.go"}},
+			wantErr: "failed to parse CA certificate",
+		},
+		{
+			name:    "missing client certificate",
+			cfg:     Config{TLS: TLSConfig{CertFile: "testdata/missing.pem"}},
+			wantErr: "failed to load client certificate",
+		},
+		{
+			// TLS files are not read for insecure connections
+			name: "insecure ignores TLS",
+			cfg:  Config{Insecure: true, TLS: TLSConfig{CAFile: "testdata/missing.pem"}},
+			want: exporterOptions{endpoint: "collector:4317", insecure: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newExporterOptions(tt.cfg, "collector:4317")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("newExporterOptions() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newExporterOptions() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newExporterOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewExporterOptionsEndpointURL(t *testing.T) {
+	got, err := newExporterOptions(Config{Insecure: true}, "https://collector:4318")
+	if err != nil {
+		t.Fatalf("newExporterOptions() error = %v", err)
+	}
+	// The URL scheme decides on TLS, whatever Insecure says
+	if !got.endpointURL || got.insecure {
+		t.Errorf("newExporterOptions() = %+v, want a secure endpoint URL", got)
+	}
+}
+
+// setenv sets the variable for the test, or unsets it when value is empty.
+func setenv(t *testing.T, name, value string) {
+	t.Setenv(name, value)
+	if value == "" {
+		os.Unsetenv(name)
+	}
+}
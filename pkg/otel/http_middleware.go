@@ -1,24 +1,114 @@
 package otel
 
 import (
-	"fmt"
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// NewHTTPHandler wraps an http.Handler with OpenTelemetry instrumentation.
-func OTelOperationMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		operation := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+// RouteResolver returns the route pattern that handled a request, e.g. "/trips/{id}/cancel".
+// It is called once the request has been handled, and returns "" when the route is unknown.
+type RouteResolver func(*http.Request) string
 
-		// Wrap the next handler with otelhttp using the generated operation name
-		otelHandler := otelhttp.NewHandler(next, operation)
+// ServeMuxPattern resolves the pattern matched by a Go 1.22+ http.ServeMux, without its method.
+func ServeMuxPattern(r *http.Request) string {
+	pattern := r.Pattern
+	if i := strings.IndexByte(pattern, ' '); i >= 0 {
+		pattern = strings.TrimLeft(pattern[i+1:], " ")
+	}
+	return pattern
+}
+
+// ContextRoute resolves the route recorded with SetRoute, e.g. by GinRouteMiddleware.
+func ContextRoute(r *http.Request) string {
+	if route, ok := r.Context().Value(routeKey{}).(*string); ok {
+		return *route
+	}
+	return ""
+}
+
+type routeKey struct{}
+
+// SetRoute records the route pattern of a request handled behind OTelOperationMiddleware,
+// for routers that do not set http.Request.Pattern.
+func SetRoute(ctx context.Context, route string) {
+	if r, ok := ctx.Value(routeKey{}).(*string); ok {
+		*r = route
+	}
+}
+
+// GinRouteMiddleware records gin's FullPath with SetRoute, for a gin.Engine served behind OTelOperationMiddleware.
+func GinRouteMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		SetRoute(c.Request.Context(), c.FullPath())
+		c.Next()
+	}
+}
+
+// HTTPOption configures OTelOperationMiddleware.
+type HTTPOption func(*httpConfig)
+
+type httpConfig struct {
+	resolver RouteResolver
+}
 
-		otelHandler.ServeHTTP(w, r)
+// WithRouteResolver sets how route patterns are resolved.
+// Defaults to the route recorded with SetRoute, then the http.ServeMux pattern.
+func WithRouteResolver(resolver RouteResolver) HTTPOption {
+	return func(c *httpConfig) {
+		c.resolver = resolver
+	}
+}
+
+func defaultRouteResolver(r *http.Request) string {
+	if route := ContextRoute(r); route != "" {
+		return route
+	}
+	return ServeMuxPattern(r)
+}
+
+// OTelOperationMiddleware wraps an http.Handler with OpenTelemetry tracing and HTTP server metrics.
+// Spans are named "METHOD /route/{pattern}" once the route is resolved, or "METHOD" for unknown routes,
+// so that path parameters never end up in span names.
+func OTelOperationMiddleware(next http.Handler, opts ...HTTPOption) http.Handler {
+	cfg := httpConfig{resolver: defaultRouteResolver}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	spanName := func(_ string, r *http.Request) string {
+		if route := cfg.resolver(r); route != "" {
+			return r.Method + " " + route
+		}
+		return r.Method
+	}
+
+	routed := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Keep the request handed to the router, which is where the route gets recorded
+		var route string
+		r = r.WithContext(context.WithValue(r.Context(), routeKey{}, &route))
+
+		next.ServeHTTP(w, r)
+
+		if pattern := cfg.resolver(r); pattern != "" {
+			attr := semconv.HTTPRoute(pattern)
+
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern)
+			span.SetAttributes(attr)
+
+			labeler, _ := otelhttp.LabelerFromContext(r.Context())
+			labeler.Add(attr)
+		}
 	})
+
+	return otelhttp.NewHandler(routed, "", otelhttp.WithSpanNameFormatter(spanName))
 }
 
 // GinMiddleware returns a Gin middleware that adds OpenTelemetry tracing.
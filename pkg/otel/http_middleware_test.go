@@ -0,0 +1,86 @@
+package otel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ride4Low/contracts/pkg/otel/oteltest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestOTelOperationMiddlewareServeMuxPattern(t *testing.T) {
+	rec := oteltest.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /trips/{id}/cancel", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := OTelOperationMiddleware(mux)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/trips/42/cancel", nil))
+
+	span := rec.FindSpan("POST /trips/{id}/cancel")
+	rec.AssertAttributes(span, semconv.HTTPRoute("/trips/{id}/cancel"))
+	assertRouteMetric(t, rec, "/trips/{id}/cancel")
+}
+
+func TestOTelOperationMiddlewareGinRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := oteltest.New(t)
+
+	engine := gin.New()
+	engine.Use(GinRouteMiddleware())
+	engine.GET("/drivers/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	handler := OTelOperationMiddleware(engine)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/drivers/42", nil))
+
+	span := rec.FindSpan("GET /drivers/:id")
+	rec.AssertAttributes(span, semconv.HTTPRoute("/drivers/:id"))
+	assertRouteMetric(t, rec, "/drivers/:id")
+}
+
+func TestOTelOperationMiddlewareUnmatchedRoute(t *testing.T) {
+	rec := oteltest.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /trips/{id}/cancel", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := OTelOperationMiddleware(mux)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/unknown/42", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	span := rec.FindSpan("GET")
+	for _, attr := range span.Attributes() {
+		if attr.Key == semconv.HTTPRouteKey {
+			t.Errorf("span has route %q, want none", attr.Value.AsString())
+		}
+	}
+}
+
+// assertRouteMetric checks that the HTTP server duration is recorded with the route.
+func assertRouteMetric(t *testing.T, rec *oteltest.Recorder, route string) {
+	t.Helper()
+
+	m := rec.FindMetric("http.server.request.duration")
+	histogram, ok := m.Data.(metricdata.Histogram[float64])
+	if !ok {
+		t.Fatalf("metric %q is a %T, want a float64 histogram", m.Name, m.Data)
+	}
+	for _, dp := range histogram.DataPoints {
+		if value, ok := dp.Attributes.Value(semconv.HTTPRouteKey); ok && value.AsString() == route {
+			return
+		}
+	}
+	t.Errorf("metric %q has no data point with route %q", m.Name, route)
+}
@@ -0,0 +1,142 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupLogging(ctx context.Context, cfg Config, res *resource.Resource) (*sdklog.LoggerProvider, error) {
+	exporter, err := newLogExporter(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log exporter: %w", err)
+	}
+
+	opts := []sdklog.LoggerProviderOption{
+		sdklog.WithResource(res),
+	}
+
+	if exporter != nil {
+		opts = append(opts, sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)))
+	}
+
+	return sdklog.NewLoggerProvider(opts...), nil
+}
+
+// newLogger returns a logger writing records at or above cfg.LogLevel to stderr and,
+// when loggerProvider is set, to OpenTelemetry.
+// Records written to stderr with a context holding a span get trace_id and span_id attributes;
+// OpenTelemetry records carry the span context natively.
+func newLogger(cfg Config, loggerProvider *sdklog.LoggerProvider) *slog.Logger {
+	return slog.New(newLogHandler(cfg, os.Stderr, loggerProvider))
+}
+
+func newLogHandler(cfg Config, w io.Writer, loggerProvider *sdklog.LoggerProvider) slog.Handler {
+	handlers := fanoutHandler{
+		traceHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{Level: cfg.LogLevel})},
+	}
+
+	if loggerProvider != nil {
+		handlers = append(handlers, levelHandler{
+			level: cfg.LogLevel,
+			Handler: otelslog.NewHandler(cfg.ServiceName,
+				otelslog.WithLoggerProvider(loggerProvider),
+				otelslog.WithVersion(cfg.ServiceVersion),
+			),
+		})
+	}
+
+	return handlers
+}
+
+// levelHandler drops records below level.
+type levelHandler struct {
+	level slog.Leveler
+	slog.Handler
+}
+
+func (h levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level() && h.Handler.Enabled(ctx, level)
+}
+
+func (h levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return levelHandler{h.level, h.Handler.WithAttrs(attrs)}
+}
+
+func (h levelHandler) WithGroup(name string) slog.Handler {
+	return levelHandler{h.level, h.Handler.WithGroup(name)}
+}
+
+// traceHandler adds the trace and span IDs of the context's span to every record.
+type traceHandler struct {
+	slog.Handler
+}
+
+func (h traceHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h traceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return traceHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h traceHandler) WithGroup(name string) slog.Handler {
+	return traceHandler{h.Handler.WithGroup(name)}
+}
+
+// fanoutHandler sends every record to all of its handlers.
+type fanoutHandler []slog.Handler
+
+func (h fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, r.Level) {
+			errs = append(errs, handler.Handle(ctx, r.Clone()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+
+	return handlers
+}
+
+func (h fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+
+	return handlers
+}
@@ -0,0 +1,68 @@
+package otel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/ride4Low/contracts/pkg/otel/oteltest"
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestLoggerLevelAppliesToEveryHandler(t *testing.T) {
+	rec := oteltest.New(t)
+	var stderr bytes.Buffer
+	logger := slog.New(newLogHandler(Config{ServiceName: "test", LogLevel: slog.LevelInfo}, &stderr, rec.LoggerProvider))
+
+	logger.Debug("debug record")
+	logger.Info("info record")
+
+	for _, record := range rec.Logs() {
+		if record.Body().AsString() == "debug record" {
+			t.Error("debug record exported below the configured level")
+		}
+	}
+	rec.FindLog("info record")
+
+	if bytes.Contains(stderr.Bytes(), []byte("debug record")) {
+		t.Error("debug record written to stderr below the configured level")
+	}
+}
+
+func TestLoggerTraceIDsOnStderrOnly(t *testing.T) {
+	rec := oteltest.New(t)
+	var stderr bytes.Buffer
+	logger := slog.New(newLogHandler(Config{ServiceName: "test"}, &stderr, rec.LoggerProvider))
+
+	ctx, span := rec.Tracer("test").Start(context.Background(), "operation")
+	logger.InfoContext(ctx, "in span")
+	span.End()
+
+	var line map[string]any
+	if err := json.Unmarshal(stderr.Bytes(), &line); err != nil {
+		t.Fatalf("failed to decode stderr record: %v", err)
+	}
+	if line["trace_id"] != span.SpanContext().TraceID().String() || line["span_id"] != span.SpanContext().SpanID().String() {
+		t.Errorf("stderr record = %v, want trace_id and span_id of the span", line)
+	}
+
+	record := rec.FindLog("in span")
+	if record.TraceID() != span.SpanContext().TraceID() || record.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("exported record span context = %s/%s, want the span's", record.TraceID(), record.SpanID())
+	}
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		if kv.Key == "trace_id" || kv.Key == "span_id" {
+			t.Errorf("exported record has a %s attribute", kv.Key)
+		}
+		return true
+	})
+}
+
+func TestWithTraceIDsDoesNotWrapProviderLogger(t *testing.T) {
+	logger := newLogger(Config{}, nil).With("service", "test")
+	if withTraceIDs(logger) != logger {
+		t.Error("withTraceIDs() wrapped a logger that already adds trace IDs")
+	}
+}
@@ -0,0 +1,119 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/host"
+	"go.opentelemetry.io/contrib/instrumentation/runtime"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Metrics exporters
+const (
+	// MetricsExporterOTLP pushes metrics to the OTLP collector.
+	MetricsExporterOTLP = "otlp"
+	// MetricsExporterPrometheus serves metrics on a Prometheus scrape endpoint.
+	MetricsExporterPrometheus = "prometheus"
+)
+
+func setupMetrics(ctx context.Context, cfg Config, res *resource.Resource) (*sdkmetric.MeterProvider, *http.Server, error) {
+	var (
+		reader sdkmetric.Reader
+		server *http.Server
+	)
+
+	switch cfg.MetricsExporter {
+	case "", MetricsExporterOTLP:
+		exporter, err := newMetricExporter(ctx, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create metric exporter: %w", err)
+		}
+		if exporter != nil {
+			reader = sdkmetric.NewPeriodicReader(exporter,
+				sdkmetric.WithInterval(cfg.MetricsInterval),
+			)
+		}
+	case MetricsExporterPrometheus:
+		registry := promclient.NewRegistry()
+		exporter, err := prometheus.New(prometheus.WithRegisterer(registry))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create prometheus exporter: %w", err)
+		}
+		reader = exporter
+
+		server, err = servePrometheus(cfg.PrometheusAddr, registry)
+		if err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown metrics exporter: %q", cfg.MetricsExporter)
+	}
+
+	opts := []sdkmetric.Option{
+		sdkmetric.WithResource(res),
+	}
+
+	if reader != nil {
+		opts = append(opts, sdkmetric.WithReader(reader))
+	}
+
+	meterProvider := sdkmetric.NewMeterProvider(opts...)
+
+	// Go runtime and process metrics
+	if err := runtime.Start(runtime.WithMeterProvider(meterProvider)); err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("failed to start runtime metrics: %w", err), shutdownMetrics(ctx, meterProvider, server))
+	}
+	if err := host.Start(host.WithMeterProvider(meterProvider)); err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("failed to start host metrics: %w", err), shutdownMetrics(ctx, meterProvider, server))
+	}
+
+	return meterProvider, server, nil
+}
+
+// servePrometheus serves the registry on /metrics at addr until the server is shut down.
+func servePrometheus(addr string, registry *promclient.Registry) (*http.Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for prometheus metrics: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	// Addr tells the actual address when addr has port 0
+	server := &http.Server{Addr: listener.Addr().String(), Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Prometheus metrics server stopped", "error", err)
+		}
+	}()
+
+	return server, nil
+}
+
+func shutdownMetrics(ctx context.Context, meterProvider *sdkmetric.MeterProvider, server *http.Server) error {
+	var errs []error
+
+	if meterProvider != nil {
+		if err := meterProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to shutdown meter provider: %w", err))
+		}
+	}
+
+	if server != nil {
+		if err := server.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to shutdown prometheus server: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,93 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{
+		EnableMetrics:   true,
+		MetricsExporter: MetricsExporterPrometheus,
+		PrometheusAddr:  "127.0.0.1:0",
+	}
+
+	meterProvider, server, err := setupMetrics(ctx, cfg, resource.Empty())
+	if err != nil {
+		t.Fatalf("setupMetrics() error = %v", err)
+	}
+	provider := &Provider{meterProvider: meterProvider, prometheusServer: server}
+	t.Cleanup(func() { _ = provider.Shutdown(ctx) })
+
+	counter, err := meterProvider.Meter("test").Int64Counter("test.requests")
+	if err != nil {
+		t.Fatalf("Int64Counter() error = %v", err)
+	}
+	counter.Add(ctx, 3)
+
+	resp, err := http.Get("http://" + server.Addr + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to scrape metrics: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read metrics: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if !strings.Contains(string(body), "test_requests_total") {
+		t.Errorf("scraped metrics do not contain test_requests_total:\n%s", body)
+	}
+}
+
+func TestProviderShutdownJoinsMetricsErrors(t *testing.T) {
+	cfg := Config{
+		EnableMetrics:   true,
+		MetricsExporter: MetricsExporterPrometheus,
+		PrometheusAddr:  "127.0.0.1:0",
+	}
+
+	meterProvider, server, err := setupMetrics(context.Background(), cfg, resource.Empty())
+	if err != nil {
+		t.Fatalf("setupMetrics() error = %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+
+	// A meter provider fails to shut down twice
+	if err := meterProvider.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	// An open connection keeps the server from shutting down before the context is done
+	conn, err := net.Dial("tcp", server.Addr)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	provider := &Provider{meterProvider: meterProvider, prometheusServer: server}
+	err = provider.Shutdown(ctx)
+
+	for _, want := range []string{"failed to shutdown meter provider", "failed to shutdown prometheus server"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Shutdown() error = %v, want it to contain %q", err, want)
+		}
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Shutdown() error = %v, want it to wrap %v", err, context.Canceled)
+	}
+}
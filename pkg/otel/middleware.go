@@ -1,20 +1,217 @@
 package otel
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// ServerOptions returns gRPC server options with tracing interceptors
-func ServerOptions() []grpc.ServerOption {
+// DefaultMaxMessageSize is the default limit of gRPC request and response payloads.
+const DefaultMaxMessageSize = 4 << 20 // 4 MiB
+
+// GRPCOption configures the options returned by ServerOptions and ClientOptions.
+type GRPCOption func(*grpcConfig)
+
+type grpcConfig struct {
+	logger         *slog.Logger
+	maxRecvMsgSize int
+	maxSendMsgSize int
+}
+
+// WithGRPCLogger sets the logger used for request logging and recovered panics.
+// Defaults to slog.Default().
+func WithGRPCLogger(logger *slog.Logger) GRPCOption {
+	return func(c *grpcConfig) {
+		c.logger = logger
+	}
+}
+
+// WithMaxRecvMsgSize limits the size in bytes of received payloads. Defaults to DefaultMaxMessageSize.
+func WithMaxRecvMsgSize(size int) GRPCOption {
+	return func(c *grpcConfig) {
+		c.maxRecvMsgSize = size
+	}
+}
+
+// WithMaxSendMsgSize limits the size in bytes of sent payloads. Defaults to DefaultMaxMessageSize.
+func WithMaxSendMsgSize(size int) GRPCOption {
+	return func(c *grpcConfig) {
+		c.maxSendMsgSize = size
+	}
+}
+
+func newGRPCConfig(opts []GRPCOption) grpcConfig {
+	cfg := grpcConfig{
+		logger:         slog.Default(),
+		maxRecvMsgSize: DefaultMaxMessageSize,
+		maxSendMsgSize: DefaultMaxMessageSize,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	cfg.logger = withTraceIDs(cfg.logger)
+
+	return cfg
+}
+
+// withTraceIDs makes the logger add trace and span IDs, unless it already does,
+// as Provider.Logger does.
+func withTraceIDs(logger *slog.Logger) *slog.Logger {
+	switch logger.Handler().(type) {
+	case traceHandler, fanoutHandler:
+		return logger
+	}
+	return slog.New(traceHandler{logger.Handler()})
+}
+
+// ServerOptions returns gRPC server options with tracing and RPC metrics, request logging,
+// panic recovery and payload size limits.
+func ServerOptions(opts ...GRPCOption) []grpc.ServerOption {
+	cfg := newGRPCConfig(opts)
+
 	return []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		// Logging comes first so that it sees the status of recovered panics
+		grpc.ChainUnaryInterceptor(
+			UnaryServerLogging(cfg.logger),
+			UnaryServerRecovery(cfg.logger),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamServerLogging(cfg.logger),
+			StreamServerRecovery(cfg.logger),
+		),
+		grpc.MaxRecvMsgSize(cfg.maxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.maxSendMsgSize),
 	}
 }
 
-// ClientOptions returns gRPC client dial options with tracing interceptors
-func ClientOptions() []grpc.DialOption {
+// ClientOptions returns gRPC client dial options with tracing and RPC metrics, request logging
+// and payload size limits.
+func ClientOptions(opts ...GRPCOption) []grpc.DialOption {
+	cfg := newGRPCConfig(opts)
+
 	return []grpc.DialOption{
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(UnaryClientLogging(cfg.logger)),
+		grpc.WithChainStreamInterceptor(StreamClientLogging(cfg.logger)),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(cfg.maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(cfg.maxSendMsgSize),
+		),
+	}
+}
+
+// UnaryServerRecovery converts panics in handlers to codes.Internal errors.
+func UnaryServerRecovery(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ctx, logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRecovery converts panics in stream handlers to codes.Internal errors.
+func StreamServerRecovery(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, logger *slog.Logger, method string, r any) error {
+	logger.ErrorContext(ctx, "Recovered from panic",
+		"method", method,
+		"panic", fmt.Sprint(r),
+		"stack", string(debug.Stack()),
+	)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+// UnaryServerLogging logs every unary request with its status code and duration.
+func UnaryServerLogging(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRPC(ctx, logger, "Handled request", info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerLogging logs every stream with its status code and duration once it ends.
+func StreamServerLogging(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ss.Context(), logger, "Handled stream", info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// UnaryClientLogging logs every unary call with its status code and duration.
+func UnaryClientLogging(logger *slog.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		logRPC(ctx, logger, "Called method", method, start, err)
+
+		return err
+	}
+}
+
+// StreamClientLogging logs streams that fail to open. Errors received later are returned to the caller.
+func StreamClientLogging(logger *slog.Logger) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			logRPC(ctx, logger, "Failed to open stream", method, start, err)
+		}
+
+		return stream, err
+	}
+}
+
+func logRPC(ctx context.Context, logger *slog.Logger, msg, method string, start time.Time, err error) {
+	code := status.Code(err)
+	attrs := []any{
+		"method", method,
+		"code", code.String(),
+		"duration", time.Since(start),
+	}
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+
+	logger.Log(ctx, levelForCode(code), msg, attrs...)
+}
+
+// levelForCode logs server-side failures as errors and client mistakes as warnings.
+func levelForCode(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
 	}
 }
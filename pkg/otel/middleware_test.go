@@ -0,0 +1,203 @@
+package otel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// panickingHealthServer panics for the "panic" service and reports every other service as serving.
+type panickingHealthServer struct {
+	healthpb.UnimplementedHealthServer
+}
+
+func (panickingHealthServer) Check(_ context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if req.GetService() == "panic" {
+		panic("boom")
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (panickingHealthServer) Watch(*healthpb.HealthCheckRequest, grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	panic("boom")
+}
+
+// logBuffer collects JSON log records written concurrently by gRPC handlers and calls.
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) records(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("failed to decode log record %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// findRecord returns the first record with the given message and method.
+func (b *logBuffer) findRecord(t *testing.T, msg, method string) map[string]any {
+	t.Helper()
+
+	records := b.records(t)
+	for _, record := range records {
+		if record["msg"] == msg && record["method"] == method {
+			return record
+		}
+	}
+	t.Fatalf("no %q log record for %s in %v", msg, method, records)
+	return nil
+}
+
+// newTestServer serves the health service over an in-memory connection, and returns a client for it
+// along with the server and client logs.
+func newTestServer(t *testing.T, maxMessageSize int) (healthpb.HealthClient, *logBuffer, *logBuffer) {
+	t.Helper()
+
+	serverLogs, clientLogs := &logBuffer{}, &logBuffer{}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(ServerOptions(
+		WithGRPCLogger(slog.New(slog.NewJSONHandler(serverLogs, nil))),
+		WithMaxRecvMsgSize(maxMessageSize),
+	)...)
+	healthpb.RegisterHealthServer(server, panickingHealthServer{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	opts := append(ClientOptions(WithGRPCLogger(slog.New(slog.NewJSONHandler(clientLogs, nil)))),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return healthpb.NewHealthClient(conn), serverLogs, clientLogs
+}
+
+func TestServerOptionsRecoverUnaryPanics(t *testing.T) {
+	client, serverLogs, clientLogs := newTestServer(t, DefaultMaxMessageSize)
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "panic"})
+	if got := status.Code(err); got != codes.Internal {
+		t.Fatalf("Check() code = %v, want %v (error = %v)", got, codes.Internal, err)
+	}
+
+	method := healthpb.Health_Check_FullMethodName
+	panicked := serverLogs.findRecord(t, "Recovered from panic", method)
+	if panicked["panic"] != "boom" || panicked["level"] != "ERROR" {
+		t.Errorf("panic record = %v, want an ERROR with panic %q", panicked, "boom")
+	}
+
+	handled := serverLogs.findRecord(t, "Handled request", method)
+	if handled["code"] != codes.Internal.String() || handled["level"] != "ERROR" {
+		t.Errorf("request record = %v, want an ERROR with code %v", handled, codes.Internal)
+	}
+
+	called := clientLogs.findRecord(t, "Called method", method)
+	if called["code"] != codes.Internal.String() {
+		t.Errorf("client record = %v, want code %v", called, codes.Internal)
+	}
+}
+
+func TestServerOptionsRecoverStreamPanics(t *testing.T) {
+	client, serverLogs, _ := newTestServer(t, DefaultMaxMessageSize)
+
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	_, err = stream.Recv()
+	if got := status.Code(err); got != codes.Internal {
+		t.Fatalf("Recv() code = %v, want %v (error = %v)", got, codes.Internal, err)
+	}
+
+	handled := serverLogs.findRecord(t, "Handled stream", healthpb.Health_Watch_FullMethodName)
+	if handled["code"] != codes.Internal.String() {
+		t.Errorf("stream record = %v, want code %v", handled, codes.Internal)
+	}
+}
+
+func TestServerOptionsLogSuccessAsInfo(t *testing.T) {
+	client, serverLogs, _ := newTestServer(t, DefaultMaxMessageSize)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "trip"}); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	handled := serverLogs.findRecord(t, "Handled request", healthpb.Health_Check_FullMethodName)
+	if handled["code"] != codes.OK.String() || handled["level"] != "INFO" {
+		t.Errorf("request record = %v, want an INFO with code %v", handled, codes.OK)
+	}
+}
+
+func TestServerOptionsRejectOversizedRequests(t *testing.T) {
+	client, _, clientLogs := newTestServer(t, 64)
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: strings.Repeat("x", 128)})
+	if got := status.Code(err); got != codes.ResourceExhausted {
+		t.Fatalf("Check() code = %v, want %v (error = %v)", got, codes.ResourceExhausted, err)
+	}
+
+	called := clientLogs.findRecord(t, "Called method", healthpb.Health_Check_FullMethodName)
+	if called["code"] != codes.ResourceExhausted.String() || called["level"] != "WARN" {
+		t.Errorf("client record = %v, want a WARN with code %v", called, codes.ResourceExhausted)
+	}
+}
+
+func TestLevelForCode(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want slog.Level
+	}{
+		{code: codes.OK, want: slog.LevelInfo},
+		{code: codes.InvalidArgument, want: slog.LevelWarn},
+		{code: codes.NotFound, want: slog.LevelWarn},
+		{code: codes.ResourceExhausted, want: slog.LevelWarn},
+		{code: codes.Unauthenticated, want: slog.LevelWarn},
+		{code: codes.Internal, want: slog.LevelError},
+		{code: codes.Unavailable, want: slog.LevelError},
+		{code: codes.DeadlineExceeded, want: slog.LevelError},
+	}
+
+	for _, tt := range tests {
+		if got := levelForCode(tt.code); got != tt.want {
+			t.Errorf("levelForCode(%v) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
@@ -2,12 +2,18 @@ package otel
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"time"
 
+	"github.com/ride4Low/contracts/env"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
@@ -21,47 +27,122 @@ type Config struct {
 	ServiceVersion string
 	// Environment is the deployment environment (e.g., "development", "production").
 	Environment string
-	// OTLPEndpoint is the endpoint for the OTLP collector (e.g., "localhost:4318").
+	// OTLPEndpoint is the endpoint for the OTLP collector, either host:port (e.g., "localhost:4317")
+	// or a URL (e.g., "https://collector:4318"). Empty uses the OTEL_EXPORTER_OTLP_* environment variables,
+	// except for traces, which then go to JaegerEndpoint when it is set.
 	OTLPEndpoint string
+	// Exporter selects where telemetry is exported: ExporterOTLPGRPC (default), ExporterOTLPHTTP, ExporterStdout or ExporterNone.
+	Exporter string
+	// Headers are sent with every OTLP export (e.g., authentication tokens).
+	Headers map[string]string
+	// Compression is the OTLP payload compression: none (default) or CompressionGzip.
+	Compression string
+	// TLS holds the certificates for OTLP connections when Insecure is false.
+	TLS TLSConfig
 	// EnableTracing enables trace collection.
 	EnableTracing bool
-	// JaegerEndpoint is the endpoint for the Jaeger collector (e.g., "localhost:14268").
+	// Sampling configures which spans are exported.
+	Sampling SamplingConfig
+	// BaggageAttributes are the baggage members copied onto every span as attributes.
+	BaggageAttributes []string
+	// JaegerEndpoint is the endpoint traces are sent to when OTLPEndpoint is empty.
+	// Metrics and logs are never sent to it.
+	//
+	// Deprecated: Use OTLPEndpoint.
 	JaegerEndpoint string
 	// EnableMetrics enables metric collection.
 	EnableMetrics bool
+	// MetricsExporter selects where metrics are exported: MetricsExporterOTLP (default) or MetricsExporterPrometheus.
+	MetricsExporter string
+	// MetricsInterval is how often metrics are pushed to the OTLP collector.
+	MetricsInterval time.Duration
+	// PrometheusAddr is the address the Prometheus /metrics endpoint listens on (e.g., ":9464").
+	PrometheusAddr string
 	// EnableLogging enables log collection.
 	EnableLogging bool
-	// Insecure disables TLS for OTLP connections.
+	// LogLevel is the minimum level of records written by Provider.Logger.
+	LogLevel slog.Level
+	// Insecure disables TLS for OTLP connections. Ignored when OTLPEndpoint is a URL.
 	Insecure bool
 }
 
+// TLSConfig holds PEM encoded certificate files for OTLP connections.
+type TLSConfig struct {
+	// CAFile verifies the collector's certificate. Empty uses the system roots.
+	CAFile string
+	// CertFile and KeyFile are the client certificate for mutual TLS.
+	CertFile string
+	KeyFile  string
+}
+
 // DefaultConfig returns a default configuration with common settings.
+// Exporter settings honour the standard OTEL_EXPORTER_OTLP_* and OTEL_TRACES_EXPORTER environment variables.
 func DefaultConfig(serviceName string) Config {
 	return Config{
 		ServiceName:    serviceName,
 		ServiceVersion: "1.0.0",
 		Environment:    "development",
-		OTLPEndpoint:   "",
+		OTLPEndpoint:   env.GetString("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
 		EnableTracing:  true,
 		JaegerEndpoint: "jaeger:4317",
 		EnableMetrics:  false,
 		EnableLogging:  false,
-		Insecure:       true,
+		Insecure:       env.GetBool("OTEL_EXPORTER_OTLP_INSECURE", true),
+
+		Exporter:    exporterFromEnv(),
+		Headers:     parseHeaders(env.GetString("OTEL_EXPORTER_OTLP_HEADERS", "")),
+		Compression: env.GetString("OTEL_EXPORTER_OTLP_COMPRESSION", ""),
+		TLS: TLSConfig{
+			CAFile:   env.GetString("OTEL_EXPORTER_OTLP_CERTIFICATE", ""),
+			CertFile: env.GetString("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE", ""),
+			KeyFile:  env.GetString("OTEL_EXPORTER_OTLP_CLIENT_KEY", ""),
+		},
+		Sampling:          samplingFromEnv(),
+		BaggageAttributes: DefaultBaggageAttributes,
+
+		MetricsExporter: MetricsExporterOTLP,
+		MetricsInterval: 15 * time.Second,
+		PrometheusAddr:  ":9464",
 	}
 }
 
 // Provider holds the OpenTelemetry providers for graceful shutdown.
 type Provider struct {
-	tracerProvider *sdktrace.TracerProvider
+	tracerProvider   *sdktrace.TracerProvider
+	meterProvider    *sdkmetric.MeterProvider
+	prometheusServer *http.Server
+	loggerProvider   *sdklog.LoggerProvider
+	logger           *slog.Logger
+}
+
+// Logger returns a logger that writes to stderr and, when logging is enabled, exports to OpenTelemetry.
+// Records logged with a context holding a span get trace_id and span_id attributes.
+func (p *Provider) Logger() *slog.Logger {
+	return p.logger
 }
 
-// Shutdown gracefully shuts down all providers.
+// Shutdown gracefully shuts down all providers, flushing pending telemetry.
+// It returns the errors of every provider that failed to shut down.
 func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []error
+
 	if p.tracerProvider != nil {
-		return p.tracerProvider.Shutdown(ctx)
+		if err := p.tracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to shutdown tracer provider: %w", err))
+		}
+	}
+
+	if err := shutdownMetrics(ctx, p.meterProvider, p.prometheusServer); err != nil {
+		errs = append(errs, err)
 	}
 
-	return nil
+	if p.loggerProvider != nil {
+		if err := p.loggerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to shutdown logger provider: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // Setup initializes OpenTelemetry with the given configuration.
@@ -93,7 +174,7 @@ func Setup(ctx context.Context, cfg Config) (*Provider, error) {
 
 	// Setup tracing
 	if cfg.EnableTracing {
-		tracerProvider, err := setupTracingWithGrpcJaeger(ctx, cfg, res)
+		tracerProvider, err := setupTracing(ctx, cfg, res)
 		if err != nil {
 			return nil, fmt.Errorf("failed to setup tracing: %w", err)
 		}
@@ -101,30 +182,60 @@ func Setup(ctx context.Context, cfg Config) (*Provider, error) {
 		otel.SetTracerProvider(tracerProvider)
 	}
 
+	// Setup metrics
+	if cfg.EnableMetrics {
+		meterProvider, server, err := setupMetrics(ctx, cfg, res)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to setup metrics: %w", err), provider.Shutdown(ctx))
+		}
+		provider.meterProvider = meterProvider
+		provider.prometheusServer = server
+		otel.SetMeterProvider(meterProvider)
+	}
+
+	// Setup logging
+	if cfg.EnableLogging {
+		loggerProvider, err := setupLogging(ctx, cfg, res)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to setup logging: %w", err), provider.Shutdown(ctx))
+		}
+		provider.loggerProvider = loggerProvider
+		global.SetLoggerProvider(loggerProvider)
+	}
+	provider.logger = newLogger(cfg, provider.loggerProvider)
+
 	return provider, nil
 }
 
-func setupTracingWithGrpcJaeger(ctx context.Context, cfg Config, res *resource.Resource) (*sdktrace.TracerProvider, error) {
-	opts := []otlptracegrpc.Option{
-		otlptracegrpc.WithEndpoint(cfg.JaegerEndpoint),
+func setupTracing(ctx context.Context, cfg Config, res *resource.Resource) (*sdktrace.TracerProvider, error) {
+	exporter, err := newTraceExporter(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
 	}
 
-	if cfg.Insecure {
-		opts = append(opts, otlptracegrpc.WithInsecure())
+	sampler, err := newSampler(cfg.Sampling)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sampler: %w", err)
 	}
 
-	exporter, err := otlptracegrpc.New(ctx, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler),
+	}
+
+	if len(cfg.BaggageAttributes) > 0 {
+		opts = append(opts, sdktrace.WithSpanProcessor(baggageSpanProcessor{keys: cfg.BaggageAttributes}))
 	}
 
-	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter,
+	if exporter != nil {
+		var processor sdktrace.SpanProcessor = sdktrace.NewBatchSpanProcessor(exporter,
 			sdktrace.WithBatchTimeout(5*time.Second),
-		),
-		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
-	)
+		)
+		if cfg.Sampling.AlwaysSampleErrors {
+			processor = errorSpanProcessor{next: processor}
+		}
+		opts = append(opts, sdktrace.WithSpanProcessor(processor))
+	}
 
-	return tracerProvider, nil
+	return sdktrace.NewTracerProvider(opts...), nil
 }
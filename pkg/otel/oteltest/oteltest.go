@@ -0,0 +1,268 @@
+// Package oteltest installs in-memory OpenTelemetry providers for testing instrumentation
+// without a collector.
+package oteltest
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	lognoop "go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// Recorder records the spans, metrics and logs produced through the global providers.
+type Recorder struct {
+	t       testing.TB
+	spans   *tracetest.SpanRecorder
+	metrics *sdkmetric.ManualReader
+	logs    *logExporter
+
+	TracerProvider *sdktrace.TracerProvider
+	MeterProvider  *sdkmetric.MeterProvider
+	LoggerProvider *sdklog.LoggerProvider
+}
+
+// New installs in-memory tracer, meter and logger providers and the trace context and baggage propagators
+// as the globals, and restores the previous globals when the test ends.
+// Tracers, meters and loggers obtained from the globals before the first provider was ever set
+// keep delegating to that first provider.
+// Options are added to the tracer provider, e.g. extra span processors.
+func New(t testing.TB, opts ...sdktrace.TracerProviderOption) *Recorder {
+	t.Helper()
+
+	r := &Recorder{
+		t:       t,
+		spans:   tracetest.NewSpanRecorder(),
+		metrics: sdkmetric.NewManualReader(),
+		logs:    &logExporter{},
+	}
+
+	r.TracerProvider = sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSpanProcessor(r.spans),
+	}, opts...)...)
+	r.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(r.metrics))
+	r.LoggerProvider = sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(r.logs)))
+
+	prevTracerProvider := otel.GetTracerProvider()
+	prevMeterProvider := otel.GetMeterProvider()
+	prevLoggerProvider := global.GetLoggerProvider()
+	prevPropagator := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(r.TracerProvider)
+	otel.SetMeterProvider(r.MeterProvider)
+	global.SetLoggerProvider(r.LoggerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_ = r.TracerProvider.Shutdown(ctx)
+		_ = r.MeterProvider.Shutdown(ctx)
+		_ = r.LoggerProvider.Shutdown(ctx)
+
+		// The default globals delegate to the first provider ever set, so they are replaced with no-ops
+		// rather than restored, which would keep the shut down providers installed
+		if isDefaultGlobal(prevTracerProvider) {
+			prevTracerProvider = tracenoop.NewTracerProvider()
+		}
+		if isDefaultGlobal(prevMeterProvider) {
+			prevMeterProvider = metricnoop.NewMeterProvider()
+		}
+		if isDefaultGlobal(prevLoggerProvider) {
+			prevLoggerProvider = lognoop.NewLoggerProvider()
+		}
+
+		otel.SetTracerProvider(prevTracerProvider)
+		otel.SetMeterProvider(prevMeterProvider)
+		global.SetLoggerProvider(prevLoggerProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	return r
+}
+
+// isDefaultGlobal reports whether a provider is one of the delegating defaults of the otel package.
+func isDefaultGlobal(provider any) bool {
+	typ := reflect.TypeOf(provider)
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	pkg := typ.PkgPath()
+	return pkg == "go.opentelemetry.io/otel/internal/global" || pkg == "go.opentelemetry.io/otel/log/internal/global"
+}
+
+// Spans returns the spans that have ended.
+func (r *Recorder) Spans() []sdktrace.ReadOnlySpan {
+	return r.spans.Ended()
+}
+
+// SpansByName returns the ended spans with the given name.
+func (r *Recorder) SpansByName(name string) []sdktrace.ReadOnlySpan {
+	var spans []sdktrace.ReadOnlySpan
+	for _, span := range r.spans.Ended() {
+		if span.Name() == name {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+// FindSpan returns the first ended span with the given name, failing the test if there is none.
+func (r *Recorder) FindSpan(name string) sdktrace.ReadOnlySpan {
+	r.t.Helper()
+
+	spans := r.SpansByName(name)
+	if len(spans) == 0 {
+		names := make([]string, 0, len(r.spans.Ended()))
+		for _, span := range r.spans.Ended() {
+			names = append(names, span.Name())
+		}
+		r.t.Fatalf("span %q not found, ended spans: %q", name, names)
+	}
+
+	return spans[0]
+}
+
+// AssertAttributes checks that the span has every given attribute with the same value.
+func (r *Recorder) AssertAttributes(span sdktrace.ReadOnlySpan, want ...attribute.KeyValue) {
+	r.t.Helper()
+
+	got := attribute.NewSet(span.Attributes()...)
+	for _, attr := range want {
+		value, ok := got.Value(attr.Key)
+		if !ok {
+			r.t.Errorf("span %q: attribute %q not set", span.Name(), attr.Key)
+			continue
+		}
+		if value != attr.Value {
+			r.t.Errorf("span %q: attribute %q = %q, want %q", span.Name(), attr.Key, value.Emit(), attr.Value.Emit())
+		}
+	}
+}
+
+// AssertParent checks that parent is the parent span of child.
+func (r *Recorder) AssertParent(child, parent sdktrace.ReadOnlySpan) {
+	r.t.Helper()
+
+	if child.Parent().TraceID() != parent.SpanContext().TraceID() || child.Parent().SpanID() != parent.SpanContext().SpanID() {
+		r.t.Errorf("span %q: parent is %s/%s, want span %q %s/%s", child.Name(),
+			child.Parent().TraceID(), child.Parent().SpanID(),
+			parent.Name(), parent.SpanContext().TraceID(), parent.SpanContext().SpanID(),
+		)
+	}
+}
+
+// AssertLinked checks that span links to the linked span.
+func (r *Recorder) AssertLinked(span, linked sdktrace.ReadOnlySpan) {
+	r.t.Helper()
+
+	for _, link := range span.Links() {
+		if link.SpanContext.TraceID() == linked.SpanContext().TraceID() &&
+			link.SpanContext.SpanID() == linked.SpanContext().SpanID() {
+			return
+		}
+	}
+	r.t.Errorf("span %q has no link to span %q", span.Name(), linked.Name())
+}
+
+// Metrics collects the metrics recorded so far.
+func (r *Recorder) Metrics() []metricdata.Metrics {
+	r.t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := r.metrics.Collect(context.Background(), &rm); err != nil {
+		r.t.Fatalf("failed to collect metrics: %v", err)
+	}
+
+	var metrics []metricdata.Metrics
+	for _, sm := range rm.ScopeMetrics {
+		metrics = append(metrics, sm.Metrics...)
+	}
+	return metrics
+}
+
+// FindMetric returns the metric with the given name, failing the test if it was not recorded.
+func (r *Recorder) FindMetric(name string) metricdata.Metrics {
+	r.t.Helper()
+
+	for _, m := range r.Metrics() {
+		if m.Name == name {
+			return m
+		}
+	}
+	r.t.Fatalf("metric %q not found", name)
+	return metricdata.Metrics{}
+}
+
+// Logs returns the log records emitted so far.
+func (r *Recorder) Logs() []sdklog.Record {
+	return r.logs.records()
+}
+
+// FindLog returns the first log record with the given body, failing the test if there is none.
+func (r *Recorder) FindLog(body string) sdklog.Record {
+	r.t.Helper()
+
+	for _, record := range r.logs.records() {
+		if record.Body().Kind() == log.KindString && record.Body().AsString() == body {
+			return record
+		}
+	}
+	r.t.Fatalf("log %q not found", body)
+	return sdklog.Record{}
+}
+
+// Tracer returns a tracer of the recorder's provider.
+func (r *Recorder) Tracer(name string) trace.Tracer {
+	return r.TracerProvider.Tracer(name)
+}
+
+// Meter returns a meter of the recorder's provider.
+func (r *Recorder) Meter(name string) metric.Meter {
+	return r.MeterProvider.Meter(name)
+}
+
+// logExporter keeps exported log records in memory.
+type logExporter struct {
+	mu   sync.Mutex
+	recs []sdklog.Record
+}
+
+func (e *logExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, record := range records {
+		e.recs = append(e.recs, record.Clone())
+	}
+	return nil
+}
+
+func (e *logExporter) Shutdown(context.Context) error { return nil }
+
+func (e *logExporter) ForceFlush(context.Context) error { return nil }
+
+func (e *logExporter) records() []sdklog.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]sdklog.Record(nil), e.recs...)
+}
@@ -0,0 +1,205 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ride4Low/contracts/env"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Samplers, named after the values of OTEL_TRACES_SAMPLER
+const (
+	SamplerAlwaysOn                = "always_on"
+	SamplerAlwaysOff               = "always_off"
+	SamplerTraceIDRatio            = "traceidratio"
+	SamplerParentBasedAlwaysOn     = "parentbased_always_on"
+	SamplerParentBasedAlwaysOff    = "parentbased_always_off"
+	SamplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+)
+
+// SamplingConfig configures which spans are recorded and exported.
+type SamplingConfig struct {
+	// Sampler is the base sampler. Defaults to SamplerParentBasedAlwaysOn.
+	Sampler string
+	// Ratio is the fraction of traces sampled by the ratio samplers, from 0 to 1.
+	Ratio float64
+	// Rules override the base sampler, and the parent's decision, for the spans they match.
+	// The first matching rule applies.
+	Rules []SamplingRule
+	// AlwaysSampleErrors exports spans that end with an error even when they were not sampled.
+	// Unsampled spans are then recorded in memory until they end, which has a cost on busy services.
+	AlwaysSampleErrors bool
+}
+
+// SamplingRule samples the spans it matches at Ratio.
+// Patterns match exactly, or by prefix when they end with "*".
+type SamplingRule struct {
+	// SpanName is the span name pattern, e.g. "payment.*". Empty matches every span.
+	SpanName string
+	// Attributes are attribute value patterns that must all match the attributes given at span start,
+	// e.g. {"messaging.rabbitmq.destination.routing_key": "driver.cmd.location"}.
+	Attributes map[string]string
+	Ratio      float64
+}
+
+func (r SamplingRule) matches(p sdktrace.SamplingParameters) bool {
+	if r.SpanName != "" && !matchPattern(r.SpanName, p.Name) {
+		return false
+	}
+
+	for key, pattern := range r.Attributes {
+		if !hasAttribute(p.Attributes, key, pattern) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasAttribute(attrs []attribute.KeyValue, key, pattern string) bool {
+	for _, attr := range attrs {
+		if string(attr.Key) == key && matchPattern(pattern, attr.Value.Emit()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPattern(pattern, s string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(s, prefix)
+	}
+
+	return pattern == s
+}
+
+// samplingFromEnv reads OTEL_TRACES_SAMPLER and OTEL_TRACES_SAMPLER_ARG.
+func samplingFromEnv() SamplingConfig {
+	ratio, err := strconv.ParseFloat(env.GetString("OTEL_TRACES_SAMPLER_ARG", "1"), 64)
+	if err != nil {
+		ratio = 1
+	}
+
+	return SamplingConfig{
+		Sampler: env.GetString("OTEL_TRACES_SAMPLER", SamplerParentBasedAlwaysOn),
+		Ratio:   ratio,
+	}
+}
+
+func newSampler(cfg SamplingConfig) (sdktrace.Sampler, error) {
+	var base sdktrace.Sampler
+
+	switch cfg.Sampler {
+	case "", SamplerParentBasedAlwaysOn:
+		base = sdktrace.ParentBased(sdktrace.AlwaysSample())
+	case SamplerAlwaysOn:
+		base = sdktrace.AlwaysSample()
+	case SamplerAlwaysOff:
+		base = sdktrace.NeverSample()
+	case SamplerTraceIDRatio:
+		base = sdktrace.TraceIDRatioBased(cfg.Ratio)
+	case SamplerParentBasedAlwaysOff:
+		base = sdktrace.ParentBased(sdktrace.NeverSample())
+	case SamplerParentBasedTraceIDRatio:
+		base = sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Ratio))
+	default:
+		return nil, fmt.Errorf("unknown sampler: %q", cfg.Sampler)
+	}
+
+	if len(cfg.Rules) == 0 && !cfg.AlwaysSampleErrors {
+		return base, nil
+	}
+
+	rules := make([]ruleSampler, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		rules[i] = ruleSampler{
+			rule:    rule,
+			sampler: sdktrace.TraceIDRatioBased(rule.Ratio),
+		}
+	}
+
+	return &rulesSampler{
+		base:          base,
+		rules:         rules,
+		recordDropped: cfg.AlwaysSampleErrors,
+	}, nil
+}
+
+type ruleSampler struct {
+	rule    SamplingRule
+	sampler sdktrace.Sampler
+}
+
+// rulesSampler applies the first matching rule, falling back to the base sampler.
+type rulesSampler struct {
+	base  sdktrace.Sampler
+	rules []ruleSampler
+	// recordDropped records dropped spans so errorSpanProcessor can export them if they fail
+	recordDropped bool
+}
+
+func (s *rulesSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	sampler := s.base
+	for _, r := range s.rules {
+		if r.rule.matches(p) {
+			sampler = r.sampler
+			break
+		}
+	}
+
+	result := sampler.ShouldSample(p)
+	if result.Decision == sdktrace.Drop && s.recordDropped {
+		result.Decision = sdktrace.RecordOnly
+	}
+
+	return result
+}
+
+func (s *rulesSampler) Description() string {
+	return fmt.Sprintf("RulesSampler{base:%s,rules:%d}", s.base.Description(), len(s.rules))
+}
+
+// errorSpanProcessor forwards sampled spans, and unsampled spans that ended with an error, to next.
+type errorSpanProcessor struct {
+	next sdktrace.SpanProcessor
+}
+
+func (p errorSpanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p errorSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+
+	if s.Status().Code == codes.Error {
+		p.next.OnEnd(sampledSpan{s})
+	}
+}
+
+func (p errorSpanProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p errorSpanProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// sampledSpan marks a recorded but unsampled span as sampled so exporters keep it.
+type sampledSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
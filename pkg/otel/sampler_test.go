@@ -0,0 +1,191 @@
+package otel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSamplerFromEnv(t *testing.T) {
+	tests := []struct {
+		sampler string
+		arg     string
+		// want is the prefix of the sampler's description
+		want    string
+		wantErr bool
+	}{
+		{want: "ParentBased{root:AlwaysOnSampler"},
+		{sampler: SamplerAlwaysOn, want: "AlwaysOnSampler"},
+		{sampler: SamplerAlwaysOff, want: "AlwaysOffSampler"},
+		{sampler: SamplerTraceIDRatio, arg: "0.25", want: "TraceIDRatioBased{0.25}"},
+		// A malformed ratio falls back to 1, for which TraceIDRatioBased always samples
+		{sampler: SamplerTraceIDRatio, arg: "malformed", want: "AlwaysOnSampler"},
+		{sampler: SamplerParentBasedAlwaysOn, want: "ParentBased{root:AlwaysOnSampler"},
+		{sampler: SamplerParentBasedAlwaysOff, want: "ParentBased{root:AlwaysOffSampler"},
+		{sampler: SamplerParentBasedTraceIDRatio, arg: "0.5", want: "ParentBased{root:TraceIDRatioBased{0.5}"},
+		{sampler: "jaeger_remote", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sampler+"/"+tt.arg, func(t *testing.T) {
+			setenv(t, "OTEL_TRACES_SAMPLER", tt.sampler)
+			setenv(t, "OTEL_TRACES_SAMPLER_ARG", tt.arg)
+
+			sampler, err := newSampler(samplingFromEnv())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("newSampler() = %s, want an error", sampler.Description())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newSampler() error = %v", err)
+			}
+			if got := sampler.Description(); !strings.HasPrefix(got, tt.want) {
+				t.Errorf("sampler = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{pattern: "payment.charge", s: "payment.charge", want: true},
+		{pattern: "payment.charge", s: "payment.charge.retry", want: false},
+		{pattern: "payment.*", s: "payment.charge", want: true},
+		{pattern: "payment.*", s: "trip.create", want: false},
+		{pattern: "*", s: "anything", want: true},
+		{pattern: "", s: "", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := matchPattern(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestSamplingRules(t *testing.T) {
+	sampler, err := newSampler(SamplingConfig{
+		Sampler: SamplerAlwaysOn,
+		Rules: []SamplingRule{
+			{Attributes: map[string]string{"messaging.rabbitmq.destination.routing_key": "driver.cmd.location"}, Ratio: 0},
+			{SpanName: "payment.*", Ratio: 1},
+			{SpanName: "health.*", Ratio: 0},
+		},
+	})
+	if err != nil {
+		t.Fatalf("newSampler() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		span  string
+		attrs []attribute.KeyValue
+		want  sdktrace.SamplingDecision
+	}{
+		{
+			name:  "location updates are dropped",
+			span:  "rabbitmq.publish",
+			attrs: []attribute.KeyValue{semconv.MessagingRabbitmqDestinationRoutingKey("driver.cmd.location")},
+			want:  sdktrace.Drop,
+		},
+		{
+			name: "payments are kept",
+			span: "payment.charge",
+			want: sdktrace.RecordAndSample,
+		},
+		{
+			name:  "other routing keys use the base sampler",
+			span:  "rabbitmq.publish",
+			attrs: []attribute.KeyValue{semconv.MessagingRabbitmqDestinationRoutingKey("trip.event.created")},
+			want:  sdktrace.RecordAndSample,
+		},
+		{
+			name: "health checks are dropped",
+			span: "health.check",
+			want: sdktrace.Drop,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sampler.ShouldSample(sdktrace.SamplingParameters{
+				ParentContext: context.Background(),
+				TraceID:       trace.TraceID{1},
+				Name:          tt.span,
+				Attributes:    tt.attrs,
+			})
+			if result.Decision != tt.want {
+				t.Errorf("decision = %v, want %v", result.Decision, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamplingRuleOverridesParent(t *testing.T) {
+	sampler, err := newSampler(SamplingConfig{
+		Rules: []SamplingRule{{SpanName: "payment.*", Ratio: 1}},
+	})
+	if err != nil {
+		t.Fatalf("newSampler() error = %v", err)
+	}
+
+	parent := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}))
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: parent,
+		TraceID:       trace.TraceID{1},
+		Name:          "payment.charge",
+	})
+	if result.Decision != sdktrace.RecordAndSample {
+		t.Errorf("decision = %v, want %v", result.Decision, sdktrace.RecordAndSample)
+	}
+}
+
+func TestAlwaysSampleErrors(t *testing.T) {
+	sampler, err := newSampler(SamplingConfig{Sampler: SamplerAlwaysOff, AlwaysSampleErrors: true})
+	if err != nil {
+		t.Fatalf("newSampler() error = %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithSpanProcessor(errorSpanProcessor{next: sdktrace.NewSimpleSpanProcessor(exporter)}),
+	)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	tracer := tp.Tracer("test")
+
+	_, ok := tracer.Start(context.Background(), "ok")
+	if ok.SpanContext().IsSampled() {
+		t.Fatal("span is sampled, want it only recorded")
+	}
+	ok.End()
+
+	_, failed := tracer.Start(context.Background(), "failed")
+	failed.SetStatus(codes.Error, "boom")
+	failed.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "failed" {
+		t.Fatalf("exported spans = %v, want only the failed span", spans.Snapshots())
+	}
+	if !spans[0].SpanContext.IsSampled() {
+		t.Error("exported failed span is not marked as sampled")
+	}
+}
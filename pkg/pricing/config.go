@@ -0,0 +1,161 @@
+package pricing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/ride4Low/contracts/pkg/vehicle"
+)
+
+// Package describes a vehicle package and its pricing. All amounts are in cents.
+type Package struct {
+	Slug               vehicle.PackageSlug `json:"slug"`
+	DisplayName        string              `json:"displayName"`
+	Capacity           int                 `json:"capacity"`
+	BaseFareInCents    float64             `json:"baseFareInCents"`
+	PerKmInCents       float64             `json:"perKmInCents"`
+	PerMinuteInCents   float64             `json:"perMinuteInCents"`
+	MinimumFareInCents float64             `json:"minimumFareInCents"`
+}
+
+// price returns the unadjusted price for a route given in meters and seconds.
+// Time spent waiting at stops is charged at the per-minute rate.
+func (p Package) price(distance, duration, wait float64) float64 {
+	return p.BaseFareInCents +
+		p.PerKmInCents*distance/1000 +
+		p.PerMinuteInCents*(duration+wait)/60
+}
+
+// TimeOfDayRule applies a multiplier to fares whose pickup time falls in [StartHour, EndHour).
+// A rule whose EndHour is lower than its StartHour wraps past midnight; use 0 and 24 for the whole day.
+type TimeOfDayRule struct {
+	Name       string  `json:"name"`
+	StartHour  int     `json:"startHour"`
+	EndHour    int     `json:"endHour"`
+	Multiplier float64 `json:"multiplier"`
+	// Weekdays restricts the rule to the given days (0 = Sunday). Empty means every day.
+	Weekdays []time.Weekday `json:"weekdays,omitempty"`
+}
+
+func (r TimeOfDayRule) matches(t time.Time) bool {
+	if len(r.Weekdays) > 0 && !slices.Contains(r.Weekdays, t.Weekday()) {
+		return false
+	}
+
+	hour := t.Hour()
+	if r.StartHour <= r.EndHour {
+		return hour >= r.StartHour && hour < r.EndHour
+	}
+
+	return hour >= r.StartHour || hour < r.EndHour
+}
+
+// Config holds the pricing configuration.
+type Config struct {
+	Packages []Package `json:"packages"`
+	// TimeOfDayRules are evaluated in order; the first matching rule applies.
+	TimeOfDayRules []TimeOfDayRule `json:"timeOfDayRules"`
+	// Timezone is the IANA time zone used to evaluate time-of-day rules (e.g., "Asia/Ho_Chi_Minh"). Defaults to UTC.
+	Timezone string `json:"timezone"`
+	// StopWaitMinutes is the expected wait at each intermediate stop of a multi-stop trip.
+	StopWaitMinutes float64 `json:"stopWaitMinutes"`
+}
+
+// DefaultConfig returns a configuration with the standard sedan, luxury and van packages.
+func DefaultConfig() Config {
+	return Config{
+		StopWaitMinutes: 3,
+		Packages: []Package{
+			{
+				Slug:               vehicle.Sedan,
+				DisplayName:        "Sedan",
+				Capacity:           4,
+				BaseFareInCents:    200,
+				PerKmInCents:       150,
+				PerMinuteInCents:   25,
+				MinimumFareInCents: 500,
+			},
+			{
+				Slug:               vehicle.Luxury,
+				DisplayName:        "Luxury",
+				Capacity:           4,
+				BaseFareInCents:    500,
+				PerKmInCents:       300,
+				PerMinuteInCents:   50,
+				MinimumFareInCents: 1000,
+			},
+			{
+				Slug:               vehicle.Van,
+				DisplayName:        "Van",
+				Capacity:           7,
+				BaseFareInCents:    400,
+				PerKmInCents:       200,
+				PerMinuteInCents:   35,
+				MinimumFareInCents: 800,
+			},
+		},
+	}
+}
+
+// LoadConfig reads a JSON pricing configuration from the given file.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read pricing config: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to parse pricing config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Validate reports every invalid package and rule in the configuration.
+func (c Config) Validate() error {
+	var errs []error
+
+	if len(c.Packages) == 0 {
+		errs = append(errs, errors.New("at least one package is required"))
+	}
+
+	seen := make(map[vehicle.PackageSlug]bool, len(c.Packages))
+	for i, pkg := range c.Packages {
+		if !pkg.Slug.Valid() {
+			errs = append(errs, fmt.Errorf("package %d: %w: %q", i, vehicle.ErrInvalidPackageSlug, pkg.Slug))
+		} else if seen[pkg.Slug] {
+			errs = append(errs, fmt.Errorf("package %s: duplicate slug", pkg.Slug))
+		}
+		seen[pkg.Slug] = true
+
+		if pkg.Capacity <= 0 {
+			errs = append(errs, fmt.Errorf("package %s: capacity must be positive", pkg.Slug))
+		}
+		if pkg.BaseFareInCents < 0 || pkg.PerKmInCents < 0 || pkg.PerMinuteInCents < 0 || pkg.MinimumFareInCents < 0 {
+			errs = append(errs, fmt.Errorf("package %s: fares must not be negative", pkg.Slug))
+		}
+	}
+
+	if c.StopWaitMinutes < 0 {
+		errs = append(errs, errors.New("stop wait minutes must not be negative"))
+	}
+
+	for i, rule := range c.TimeOfDayRules {
+		if rule.StartHour < 0 || rule.StartHour > 23 || rule.EndHour < 0 || rule.EndHour > 24 {
+			errs = append(errs, fmt.Errorf("time of day rule %d: hours must be within 0-24", i))
+		}
+		if rule.StartHour == rule.EndHour {
+			errs = append(errs, fmt.Errorf("time of day rule %d: start and end hours must differ", i))
+		}
+		if rule.Multiplier <= 0 {
+			errs = append(errs, fmt.Errorf("time of day rule %d: multiplier must be positive", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
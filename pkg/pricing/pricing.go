@@ -0,0 +1,157 @@
+/*
+Package pricing computes ride fares for vehicle packages from OSRM routes.
+*/
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ride4Low/contracts/pkg/vehicle"
+	"github.com/ride4Low/contracts/types"
+)
+
+// ErrUnknownPackage is returned when a fare is requested for a package slug that is not configured.
+var ErrUnknownPackage = errors.New("unknown package")
+
+// Engine computes ride fares for the configured vehicle packages.
+type Engine struct {
+	packages []Package
+	bySlug   map[vehicle.PackageSlug]Package
+	rules    []TimeOfDayRule
+	location *time.Location
+	stopWait time.Duration
+}
+
+// Request describes a ride to be priced.
+type Request struct {
+	// UserID is the rider the fares are computed for.
+	UserID string
+	// Route is the route returned by OSRM; only the first route is priced.
+	// Each intermediate stop adds the configured stop wait time.
+	Route *types.OsrmApiResponse
+	// At is the pickup time used to match time-of-day rules. Zero means now.
+	At time.Time
+	// Surge is the demand multiplier applied on top of time-of-day rules. Values <= 1 disable surge.
+	Surge float64
+}
+
+// NewEngine creates a pricing engine from the given configuration.
+func NewEngine(cfg Config) (*Engine, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid pricing config: %w", err)
+	}
+
+	location := time.UTC
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load timezone: %w", err)
+		}
+		location = loc
+	}
+
+	bySlug := make(map[vehicle.PackageSlug]Package, len(cfg.Packages))
+	for _, pkg := range cfg.Packages {
+		bySlug[pkg.Slug] = pkg
+	}
+
+	return &Engine{
+		packages: cfg.Packages,
+		bySlug:   bySlug,
+		rules:    cfg.TimeOfDayRules,
+		location: location,
+		stopWait: time.Duration(cfg.StopWaitMinutes * float64(time.Minute)),
+	}, nil
+}
+
+// Packages returns the configured vehicle packages in configuration order.
+func (e *Engine) Packages() []Package {
+	packages := make([]Package, len(e.packages))
+	copy(packages, e.packages)
+	return packages
+}
+
+// Package returns the vehicle package with the given slug.
+func (e *Engine) Package(slug vehicle.PackageSlug) (Package, bool) {
+	pkg, ok := e.bySlug[slug]
+	return pkg, ok
+}
+
+// Fares computes a RideFare for every configured package.
+func (e *Engine) Fares(req Request) ([]*types.RideFare, error) {
+	fares := make([]*types.RideFare, 0, len(e.packages))
+	for _, pkg := range e.packages {
+		fare, err := e.fare(pkg, req)
+		if err != nil {
+			return nil, err
+		}
+		fares = append(fares, fare)
+	}
+
+	return fares, nil
+}
+
+// Fare computes the RideFare for a single package.
+func (e *Engine) Fare(slug vehicle.PackageSlug, req Request) (*types.RideFare, error) {
+	pkg, ok := e.bySlug[slug]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPackage, slug)
+	}
+
+	return e.fare(pkg, req)
+}
+
+// Multiplier returns the combined time-of-day and surge multiplier for the request.
+func (e *Engine) Multiplier(req Request) float64 {
+	multiplier := 1.0
+
+	if rule, ok := e.matchRule(req.at().In(e.location)); ok {
+		multiplier *= rule.Multiplier
+	}
+
+	if req.Surge > 1 {
+		multiplier *= req.Surge
+	}
+
+	return multiplier
+}
+
+func (e *Engine) fare(pkg Package, req Request) (*types.RideFare, error) {
+	if req.Route == nil || len(req.Route.Routes) == 0 {
+		return nil, errors.New("route is required")
+	}
+
+	route := req.Route.Routes[0]
+	wait := time.Duration(route.Stops()) * e.stopWait
+	price := pkg.price(route.Distance, route.Duration, wait.Seconds()) * e.Multiplier(req)
+	price = math.Max(price, pkg.MinimumFareInCents)
+
+	return &types.RideFare{
+		UserID:            req.UserID,
+		PackageSlug:       pkg.Slug.String(),
+		TotalPriceInCents: math.Round(price),
+		Route:             req.Route,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+func (e *Engine) matchRule(t time.Time) (TimeOfDayRule, bool) {
+	for _, rule := range e.rules {
+		if rule.matches(t) {
+			return rule, true
+		}
+	}
+
+	return TimeOfDayRule{}, false
+}
+
+func (r Request) at() time.Time {
+	if r.At.IsZero() {
+		return time.Now()
+	}
+
+	return r.At
+}
@@ -0,0 +1,259 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/ride4Low/contracts/pkg/vehicle"
+	"github.com/ride4Low/contracts/types"
+)
+
+var (
+	monday   = time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	saturday = time.Date(2026, time.October, 24, 12, 0, 0, 0, time.UTC)
+)
+
+// route returns a route of the given kilometers and minutes through the given number of stops.
+func route(km, minutes float64, stops int) *types.OsrmApiResponse {
+	return &types.OsrmApiResponse{Routes: []types.OsrmRoute{{
+		Distance: km * 1000,
+		Duration: minutes * 60,
+		Legs:     make([]types.OsrmLeg, stops+1),
+	}}}
+}
+
+func at(t time.Time, hour int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), hour, 0, 0, 0, t.Location())
+}
+
+func newEngine(t *testing.T, rules []TimeOfDayRule, timezone string) *Engine {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.TimeOfDayRules = rules
+	cfg.Timezone = timezone
+
+	engine, err := NewEngine(cfg)
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+	return engine
+}
+
+func TestFare(t *testing.T) {
+	night := TimeOfDayRule{Name: "night", StartHour: 22, EndHour: 6, Multiplier: 2}
+	weekend := TimeOfDayRule{Name: "weekend", StartHour: 0, EndHour: 24, Multiplier: 1.2, Weekdays: []time.Weekday{time.Saturday, time.Sunday}}
+
+	// Sedan: 200 base + 150 per km + 25 per minute, 500 minimum, 3 minutes per stop
+	tests := []struct {
+		name     string
+		slug     vehicle.PackageSlug
+		rules    []TimeOfDayRule
+		timezone string
+		req      Request
+		want     float64
+	}{
+		{
+			name: "distance and duration",
+			slug: vehicle.Sedan,
+			req:  Request{Route: route(10, 20, 0), At: monday},
+			want: 2200,
+		},
+		{
+			name: "other package",
+			slug: vehicle.Van,
+			req:  Request{Route: route(10, 20, 0), At: monday},
+			want: 3100,
+		},
+		{
+			name: "minimum fare",
+			slug: vehicle.Sedan,
+			req:  Request{Route: route(1, 2, 0), At: monday},
+			want: 500,
+		},
+		{
+			name: "stop wait",
+			slug: vehicle.Sedan,
+			req:  Request{Route: route(10, 20, 2), At: monday},
+			want: 2350,
+		},
+		{
+			name: "surge",
+			slug: vehicle.Sedan,
+			req:  Request{Route: route(10, 20, 0), At: monday, Surge: 1.5},
+			want: 3300,
+		},
+		{
+			name: "surge below 1 is ignored",
+			slug: vehicle.Sedan,
+			req:  Request{Route: route(10, 20, 0), At: monday, Surge: 0.5},
+			want: 2200,
+		},
+		{
+			name:  "night rule before midnight",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{night},
+			req:   Request{Route: route(10, 20, 0), At: at(monday, 23)},
+			want:  4400,
+		},
+		{
+			name:  "night rule wraps past midnight",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{night},
+			req:   Request{Route: route(10, 20, 0), At: at(monday, 3)},
+			want:  4400,
+		},
+		{
+			name:  "night rule ends at end hour",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{night},
+			req:   Request{Route: route(10, 20, 0), At: at(monday, 6)},
+			want:  2200,
+		},
+		{
+			name:  "weekday filter matches",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{weekend},
+			req:   Request{Route: route(10, 20, 0), At: saturday},
+			want:  2640,
+		},
+		{
+			name:  "weekday filter excludes",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{weekend},
+			req:   Request{Route: route(10, 20, 0), At: monday},
+			want:  2200,
+		},
+		{
+			name:     "rules use the configured timezone",
+			slug:     vehicle.Sedan,
+			rules:    []TimeOfDayRule{night},
+			timezone: "Asia/Ho_Chi_Minh",
+			req:      Request{Route: route(10, 20, 0), At: at(monday, 16)}, // 23:00 in UTC+7
+			want:     4400,
+		},
+		{
+			name:  "first matching rule applies with surge",
+			slug:  vehicle.Sedan,
+			rules: []TimeOfDayRule{night, weekend},
+			req:   Request{Route: route(10, 20, 0), At: at(saturday, 23), Surge: 1.5},
+			want:  6600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngine(t, tt.rules, tt.timezone)
+
+			fare, err := engine.Fare(tt.slug, tt.req)
+			if err != nil {
+				t.Fatalf("Fare() error = %v", err)
+			}
+			if fare.TotalPriceInCents != tt.want {
+				t.Errorf("TotalPriceInCents = %v, want %v", fare.TotalPriceInCents, tt.want)
+			}
+			if fare.PackageSlug != tt.slug.String() {
+				t.Errorf("PackageSlug = %q, want %q", fare.PackageSlug, tt.slug)
+			}
+		})
+	}
+}
+
+func TestFareErrors(t *testing.T) {
+	engine := newEngine(t, nil, "")
+
+	if _, err := engine.Fare("bike", Request{Route: route(1, 1, 0)}); !errors.Is(err, ErrUnknownPackage) {
+		t.Errorf("Fare() with unknown package error = %v, want ErrUnknownPackage", err)
+	}
+	if _, err := engine.Fare(vehicle.Sedan, Request{}); err == nil {
+		t.Error("Fare() without route error = nil")
+	}
+}
+
+func TestFares(t *testing.T) {
+	engine := newEngine(t, nil, "")
+
+	fares, err := engine.Fares(Request{UserID: "user", Route: route(10, 20, 0), At: monday})
+	if err != nil {
+		t.Fatalf("Fares() error = %v", err)
+	}
+	if len(fares) != len(DefaultConfig().Packages) {
+		t.Fatalf("len(Fares()) = %d, want %d", len(fares), len(DefaultConfig().Packages))
+	}
+	for i, pkg := range DefaultConfig().Packages {
+		if fares[i].PackageSlug != pkg.Slug.String() || fares[i].UserID != "user" {
+			t.Errorf("fare %d = %+v, want package %s for user", i, fares[i], pkg.Slug)
+		}
+	}
+}
+
+func TestMultiplier(t *testing.T) {
+	engine := newEngine(t, []TimeOfDayRule{
+		{StartHour: 7, EndHour: 9, Multiplier: 1.5},
+		{StartHour: 22, EndHour: 6, Multiplier: 2},
+	}, "")
+
+	tests := []struct {
+		name string
+		req  Request
+		want float64
+	}{
+		{name: "no rule", req: Request{At: at(monday, 12)}, want: 1},
+		{name: "start hour is included", req: Request{At: at(monday, 7)}, want: 1.5},
+		{name: "end hour is excluded", req: Request{At: at(monday, 9)}, want: 1},
+		{name: "wrapping rule at midnight", req: Request{At: at(monday, 0)}, want: 2},
+		{name: "surge only", req: Request{At: at(monday, 12), Surge: 1.25}, want: 1.25},
+		{name: "rule and surge", req: Request{At: at(monday, 8), Surge: 2}, want: 3},
+		{name: "surge of 1", req: Request{At: at(monday, 8), Surge: 1}, want: 1.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := engine.Multiplier(tt.req); got != tt.want {
+				t.Errorf("Multiplier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{name: "default", modify: func(*Config) {}},
+		{name: "whole day rule", modify: func(c *Config) {
+			c.TimeOfDayRules = []TimeOfDayRule{{StartHour: 0, EndHour: 24, Multiplier: 1.1}}
+		}},
+		{name: "empty rule", wantErr: true, modify: func(c *Config) {
+			c.TimeOfDayRules = []TimeOfDayRule{{StartHour: 8, EndHour: 8, Multiplier: 1.1}}
+		}},
+		{name: "hours out of range", wantErr: true, modify: func(c *Config) {
+			c.TimeOfDayRules = []TimeOfDayRule{{StartHour: 22, EndHour: 25, Multiplier: 1.1}}
+		}},
+		{name: "non positive multiplier", wantErr: true, modify: func(c *Config) {
+			c.TimeOfDayRules = []TimeOfDayRule{{StartHour: 7, EndHour: 9}}
+		}},
+		{name: "no packages", wantErr: true, modify: func(c *Config) { c.Packages = nil }},
+		{name: "duplicate package", wantErr: true, modify: func(c *Config) {
+			c.Packages = append(c.Packages, c.Packages[0])
+		}},
+		{name: "invalid slug", wantErr: true, modify: func(c *Config) { c.Packages[0].Slug = "Sedan" }},
+		{name: "negative fare", wantErr: true, modify: func(c *Config) { c.Packages[0].PerKmInCents = -1 }},
+		{name: "negative stop wait", wantErr: true, modify: func(c *Config) { c.StopWaitMinutes = -1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.modify(&cfg)
+
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package rabbitmq
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
@@ -11,6 +10,8 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -65,47 +66,15 @@ func (c *Consumer) Consume(ctx context.Context, queueName string) error {
 		for {
 			select {
 			case <-ctx.Done():
-				log.Println("Consumer stopped")
+				c.rmq.log().InfoContext(ctx, "Consumer stopped", "queue", queueName)
 				return
 			case msg, ok := <-msgs:
 				if !ok {
-					log.Println("Channel closed")
+					c.rmq.log().InfoContext(ctx, "Channel closed", "queue", queueName)
 					return
 				}
 
-				// Extract the context from the headers
-				ctx := otel.GetTextMapPropagator().Extract(ctx, AMQPHeadersCarrier(msg.Headers))
-				tr := otel.Tracer("rabbitmq")
-				ctx, span := tr.Start(ctx, "rabbitmq.consume",
-					trace.WithAttributes(
-						// attribute.String("messaging.system", "rabbitmq"),
-						attribute.String("messaging.destination", queueName),
-						attribute.String("messaging.routing_key", msg.RoutingKey),
-					),
-				)
-
-				operation := func() (struct{}, error) {
-					return struct{}{}, c.handler.Handle(ctx, msg)
-				}
-
-				b := backoff.NewExponentialBackOff()
-				b.InitialInterval = 2 * time.Second
-				b.Multiplier = 2
-				b.MaxInterval = 5 * time.Second
-				b.RandomizationFactor = 0
-
-				_, err = backoff.Retry(ctx, operation, backoff.WithBackOff(b), backoff.WithMaxTries(3))
-				if err != nil {
-					log.Printf("Failed to handle message: %v\n", err)
-					span.RecordError(err)
-					span.SetStatus(codes.Error, err.Error())
-
-					// msg.Nack(false, false) // Don't requeue the message
-					msg.Reject(false)
-				} else {
-					msg.Ack(false)
-				}
-				span.End()
+				c.handle(ctx, queueName, msg)
 			}
 		}
 	}()
@@ -113,3 +82,79 @@ func (c *Consumer) Consume(ctx context.Context, queueName string) error {
 	return nil
 
 }
+
+// handle processes a single delivery with retries, then acks or rejects it.
+func (c *Consumer) handle(ctx context.Context, queueName string, msg amqp.Delivery) {
+	inst := c.rmq.metrics()
+	start := time.Now()
+
+	// Extract the context from the headers and link the publish span to the consume span
+	ctx = otel.GetTextMapPropagator().Extract(ctx, AMQPHeadersCarrier(msg.Headers))
+	ctx = contextWithConversationID(ctx, msg.CorrelationId)
+	ctx = contextWithEventIDs(ctx, msg.Body)
+
+	tr := otel.Tracer(instrumentationName)
+	ctx, span := tr.Start(ctx, "rabbitmq.consume",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitmq,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingOperationName("consume"),
+			semconv.MessagingDestinationName(queueName),
+			semconv.MessagingRabbitmqDestinationRoutingKey(msg.RoutingKey),
+			semconv.MessagingMessageID(msg.MessageId),
+			semconv.MessagingMessageBodySize(len(msg.Body)),
+			semconv.MessagingMessageConversationID(msg.CorrelationId),
+		),
+	)
+	defer span.End()
+
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(queueName),
+	}
+	inFlight := metric.WithAttributes(attrs...)
+	inst.inFlight.Add(ctx, 1, inFlight)
+	defer inst.inFlight.Add(ctx, -1, inFlight)
+
+	attrs = append(attrs, semconv.MessagingRabbitmqDestinationRoutingKey(msg.RoutingKey))
+
+	attempts := 0
+	operation := func() (struct{}, error) {
+		attempts++
+		return struct{}{}, c.handler.Handle(ctx, msg)
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = 2 * time.Second
+	b.Multiplier = 2
+	b.MaxInterval = 5 * time.Second
+	b.RandomizationFactor = 0
+
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(b), backoff.WithMaxTries(3))
+
+	if attempts > 1 {
+		inst.retries.Add(ctx, int64(attempts-1), metric.WithAttributes(attrs...))
+	}
+
+	if err != nil {
+		c.rmq.log().ErrorContext(ctx, "Failed to handle message",
+			"queue", queueName,
+			"routing_key", msg.RoutingKey,
+			"error", err,
+		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		// msg.Nack(false, false) // Don't requeue the message
+		msg.Reject(false)
+		inst.rejected.Add(ctx, 1, metric.WithAttributes(attrs...))
+
+		attrs = append(attrs, semconv.ErrorTypeKey.String(fmt.Sprintf("%T", err)))
+	} else {
+		msg.Ack(false)
+	}
+
+	inst.processDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+}
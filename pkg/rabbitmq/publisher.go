@@ -3,56 +3,91 @@ package rabbitmq
 import (
 	"context"
 	"fmt"
-	"log"
+	"time"
 
 	"github.com/bytedance/sonic"
+	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/ride4Low/contracts/events"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type Publisher struct {
 	rmq *RabbitMQ
+	// publish sends a message to the broker, replaced in tests that run without a channel
+	publish func(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error
 }
 
 func NewPublisher(rmq *RabbitMQ) *Publisher {
 	return &Publisher{
-		rmq: rmq,
+		rmq:     rmq,
+		publish: rmq.publish,
 	}
 }
 
 func (p *Publisher) PublishMessage(ctx context.Context, routingKey string, message events.AmqpMessage) error {
-	log.Printf("Publishing message with routing key: %s", routingKey)
+	messageID := uuid.NewString()
+	// Messages published while handling another message continue its conversation
+	conversationID := conversationIDFromContext(ctx)
+	if conversationID == "" {
+		conversationID = messageID
+	}
 
-	tr := otel.Tracer("rabbitmq")
+	tr := otel.Tracer(instrumentationName)
 	ctx, span := tr.Start(ctx, "rabbitmq.publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
 		trace.WithAttributes(
-			// attribute.String("messaging.system", "rabbitmq"),
-			// attribute.String("messaging.destination", routingKey),
-			attribute.String("messaging.routing_key", routingKey),
+			semconv.MessagingSystemRabbitmq,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingOperationName("publish"),
+			semconv.MessagingDestinationName(TripExchange),
+			semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
+			semconv.MessagingMessageID(messageID),
+			semconv.MessagingMessageConversationID(conversationID),
 		),
 	)
 	defer span.End()
 
+	p.rmq.log().InfoContext(ctx, "Publishing message", "routing_key", routingKey)
+
 	jsonMsg, err := sonic.Marshal(message)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %v", err)
 	}
+	span.SetAttributes(semconv.MessagingMessageBodySize(len(jsonMsg)))
 
 	headers := make(amqp.Table)
 	otel.GetTextMapPropagator().Inject(ctx, AMQPHeadersCarrier(headers))
 
 	msg := amqp.Publishing{
-		Headers:      headers,
-		ContentType:  "application/json",
-		Body:         jsonMsg,
-		DeliveryMode: amqp.Persistent,
+		Headers:       headers,
+		ContentType:   "application/json",
+		MessageId:     messageID,
+		CorrelationId: conversationID,
+		Body:          jsonMsg,
+		DeliveryMode:  amqp.Persistent,
+	}
+
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingOperationName("publish"),
+		semconv.MessagingDestinationName(TripExchange),
+		semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
 	}
 
-	if err = p.rmq.publish(ctx, TripExchange, routingKey, msg); err != nil {
+	start := time.Now()
+	err = p.publish(ctx, TripExchange, routingKey, msg)
+	if err != nil {
+		attrs = append(attrs, semconv.ErrorTypeKey.String(fmt.Sprintf("%T", err)))
+	}
+	p.rmq.metrics().publishDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return fmt.Errorf("failed to publish message: %v", err)
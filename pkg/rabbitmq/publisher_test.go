@@ -0,0 +1,78 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/ride4Low/contracts/events"
+	telemetry "github.com/ride4Low/contracts/pkg/otel"
+	"github.com/ride4Low/contracts/pkg/otel/oteltest"
+	"github.com/ride4Low/contracts/proto/trip"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestPublishConsumeTraceContinuity(t *testing.T) {
+	rec := oteltest.New(t, sdktrace.WithSpanProcessor(telemetry.NewBaggageSpanProcessor(telemetry.DefaultBaggageAttributes...)))
+
+	rmq := &RabbitMQ{}
+	WithMeterProvider(rec.MeterProvider)(rmq)
+
+	// Deliver the published message as the broker would
+	var delivery amqp.Delivery
+	publisher := NewPublisher(rmq)
+	publisher.publish = func(_ context.Context, _, routingKey string, msg amqp.Publishing) error {
+		delivery = amqp.Delivery{
+			Headers:       msg.Headers,
+			ContentType:   msg.ContentType,
+			MessageId:     msg.MessageId,
+			CorrelationId: msg.CorrelationId,
+			RoutingKey:    routingKey,
+			Body:          msg.Body,
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(events.TripEventData{Trip: &trip.Trip{Id: "trip-1", UserID: "user-1"}})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	ctx := telemetry.WithDriverID(context.Background(), "driver-1")
+	if err := publisher.PublishMessage(ctx, events.TripEventCompleted, events.AmqpMessage{OwnerID: "user-1", Data: data}); err != nil {
+		t.Fatalf("PublishMessage() error = %v", err)
+	}
+
+	var handled context.Context
+	consumer := NewConsumer(rmq, handlerFunc(func(ctx context.Context, _ amqp.Delivery) error {
+		handled = ctx
+		return nil
+	}))
+	consumer.handle(context.Background(), "payment_queue", delivery)
+	if handled == nil {
+		t.Fatal("handler was not called")
+	}
+
+	publish := rec.FindSpan("rabbitmq.publish")
+	consume := rec.FindSpan("rabbitmq.consume")
+
+	if consume.SpanContext().TraceID() != publish.SpanContext().TraceID() {
+		t.Errorf("consume trace ID = %s, want the publish trace ID %s", consume.SpanContext().TraceID(), publish.SpanContext().TraceID())
+	}
+	rec.AssertParent(consume, publish)
+	rec.AssertLinked(consume, publish)
+	rec.AssertAttributes(consume,
+		semconv.MessagingMessageID(delivery.MessageId),
+		semconv.MessagingMessageConversationID(delivery.CorrelationId),
+		attribute.String(telemetry.BaggageTripID, "trip-1"),
+		attribute.String(telemetry.BaggageUserID, "user-1"),
+		attribute.String(telemetry.BaggageDriverID, "driver-1"),
+	)
+
+	if got := conversationIDFromContext(handled); got != delivery.CorrelationId {
+		t.Errorf("handler conversation ID = %q, want %q", got, delivery.CorrelationId)
+	}
+}
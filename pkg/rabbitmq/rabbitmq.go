@@ -2,9 +2,13 @@ package rabbitmq
 
 import (
 	"fmt"
+	"log/slog"
+	"sync"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/ride4Low/contracts/events"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Exchange names
@@ -16,9 +20,33 @@ const (
 type RabbitMQ struct {
 	conn    *amqp.Connection
 	Channel *amqp.Channel
+	logger  *slog.Logger
+
+	meterProvider metric.MeterProvider
+	instOnce      sync.Once
+	inst          *instruments
+}
+
+// Option configures a RabbitMQ connection.
+type Option func(*RabbitMQ)
+
+// WithLogger sets the logger used by the connection and its publishers and consumers.
+// Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(r *RabbitMQ) {
+		r.logger = logger
+	}
+}
+
+// WithMeterProvider sets the meter provider of the connection's publisher and consumer metrics.
+// Defaults to the global meter provider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(r *RabbitMQ) {
+		r.meterProvider = mp
+	}
 }
 
-func NewRabbitMQ(uri string) (*RabbitMQ, error) {
+func NewRabbitMQ(uri string, opts ...Option) (*RabbitMQ, error) {
 	conn, err := amqp.Dial(uri)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to RabbitMQ: %v", err)
@@ -32,6 +60,11 @@ func NewRabbitMQ(uri string) (*RabbitMQ, error) {
 	rmq := &RabbitMQ{
 		conn:    conn,
 		Channel: channel,
+		logger:  slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(rmq)
 	}
 
 	if err := rmq.setupExchangesAndQueues(); err != nil {
@@ -42,6 +75,26 @@ func NewRabbitMQ(uri string) (*RabbitMQ, error) {
 
 }
 
+// log returns the connection's logger, or slog.Default() for a RabbitMQ not created by NewRabbitMQ.
+func (r *RabbitMQ) log() *slog.Logger {
+	if r.logger == nil {
+		return slog.Default()
+	}
+	return r.logger
+}
+
+// metrics returns the connection's instruments, created on first use.
+func (r *RabbitMQ) metrics() *instruments {
+	r.instOnce.Do(func() {
+		mp := r.meterProvider
+		if mp == nil {
+			mp = otel.GetMeterProvider()
+		}
+		r.inst = newInstruments(mp)
+	})
+	return r.inst
+}
+
 func (r *RabbitMQ) Close() error {
 	var errs []error
 	if err := r.Channel.Close(); err != nil {
@@ -59,11 +112,16 @@ func (r *RabbitMQ) Close() error {
 	return nil
 }
 
+// queueBinding describes a queue and the routing keys it is bound with.
+type queueBinding struct {
+	queueName   string
+	routingKeys []string
+	// args overrides the default dead letter configuration of the queue
+	args amqp.Table
+}
+
 func (r *RabbitMQ) setupExchangesAndQueues() error {
-	var topology = map[string][]struct {
-		queueName   string
-		routingKeys []string
-	}{
+	var topology = map[string][]queueBinding{
 		DeadLetterExchange: {
 			{
 				queueName:   events.DeadLetterQueue,
@@ -103,9 +161,84 @@ func (r *RabbitMQ) setupExchangesAndQueues() error {
 				queueName:   events.NotifyPaymentSuccessQueue,
 				routingKeys: []string{events.PaymentEventSuccess},
 			},
+			{
+				queueName:   events.NotifyPaymentFailedQueue,
+				routingKeys: []string{events.PaymentEventFailed},
+			},
+			{
+				queueName:   events.NotifyPaymentExpiredQueue,
+				routingKeys: []string{events.PaymentEventExpired},
+			},
+			{
+				queueName:   events.NotifyPaymentRefundedQueue,
+				routingKeys: []string{events.PaymentEventRefunded},
+			},
+			{
+				queueName:   events.NotifyPaymentDisputedQueue,
+				routingKeys: []string{events.PaymentEventDisputed},
+			},
+			{
+				queueName: events.TripPaymentStatusQueue,
+				routingKeys: []string{
+					events.PaymentEventFailed,
+					events.PaymentEventExpired,
+					events.PaymentEventRefunded,
+					events.PaymentEventDisputed,
+				},
+			},
+			{
+				queueName:   events.NotifyTripCancelledQueue,
+				routingKeys: []string{events.TripEventCancelled},
+			},
+			{
+				queueName:   events.NotifyTripCompletedQueue,
+				routingKeys: []string{events.TripEventCompleted},
+			},
+			{
+				queueName:   events.PaymentTripCancelledQueue,
+				routingKeys: []string{events.TripEventCancelled},
+			},
+			{
+				queueName:   events.PaymentTripCompletedQueue,
+				routingKeys: []string{events.TripEventCompleted},
+			},
+			{
+				queueName:   events.DriverRatingUpdateQueue,
+				routingKeys: []string{events.TripEventRated},
+			},
+			{
+				queueName:   events.NotificationSendQueue,
+				routingKeys: []string{events.NotificationCmdSend},
+			},
+			{
+				queueName:   events.NotificationDeliveryStatusQueue,
+				routingKeys: []string{events.NotificationEventDelivered, events.NotificationEventFailed},
+			},
+			{
+				queueName:   events.NotifyTripScheduledQueue,
+				routingKeys: []string{events.TripEventScheduled},
+			},
+			{
+				queueName:   events.ScheduledTripDispatchQueue,
+				routingKeys: []string{events.TripCmdDispatchScheduled},
+			},
 		},
 	}
 
+	// Delay queues hold scheduled trips until their TTL expires, then dead letter them
+	// back to the trip exchange for the scheduler to dispatch or delay again
+	for _, delay := range scheduleDelays {
+		topology[TripExchange] = append(topology[TripExchange], queueBinding{
+			queueName:   delay.queueName(),
+			routingKeys: []string{delay.routingKey()},
+			args: amqp.Table{
+				"x-message-ttl":             delay.ttl.Milliseconds(),
+				"x-dead-letter-exchange":    TripExchange,
+				"x-dead-letter-routing-key": events.TripCmdDispatchScheduled,
+			},
+		})
+	}
+
 	for exchange, queues := range topology {
 		if err := r.Channel.ExchangeDeclare(
 			exchange,
@@ -124,6 +257,7 @@ func (r *RabbitMQ) setupExchangesAndQueues() error {
 				queueAndRoutingKeys.queueName,
 				exchange,
 				queueAndRoutingKeys.routingKeys,
+				queueAndRoutingKeys.args,
 			); err != nil {
 				return fmt.Errorf("failed to declare and bind queue: %v", err)
 			}
@@ -133,18 +267,18 @@ func (r *RabbitMQ) setupExchangesAndQueues() error {
 	return nil
 }
 
-func (r *RabbitMQ) declareAndBindQueue(queueName string, exchangeName string, routingKeys []string) error {
-	// Add dead letter configuration
-	var args amqp.Table
-
-	if queueName != events.DeadLetterQueue {
-		args = amqp.Table{
-			"x-dead-letter-exchange": DeadLetterExchange,
-		}
-	} else {
-		args = amqp.Table{
-			"x-message-ttl": 86400000, // 1 day in milliseconds
-			// "x-message-ttl": 60000, // 1 minute
+func (r *RabbitMQ) declareAndBindQueue(queueName string, exchangeName string, routingKeys []string, args amqp.Table) error {
+	// Add dead letter configuration unless the queue provides its own
+	if args == nil {
+		if queueName != events.DeadLetterQueue {
+			args = amqp.Table{
+				"x-dead-letter-exchange": DeadLetterExchange,
+			}
+		} else {
+			args = amqp.Table{
+				"x-message-ttl": 86400000, // 1 day in milliseconds
+				// "x-message-ttl": 60000, // 1 minute
+			}
 		}
 	}
 
@@ -0,0 +1,211 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/ride4Low/contracts/events"
+	"github.com/ride4Low/contracts/proto/trip"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// scheduleDelay is a delay queue used for scheduled trips.
+// Every message in a delay queue has the same TTL, so messages expire in order.
+type scheduleDelay struct {
+	name string
+	ttl  time.Duration
+}
+
+// scheduleDelays are the delay queues, from longest to shortest.
+// A scheduled trip hops through them until its dispatch time is reached.
+var scheduleDelays = []scheduleDelay{
+	{name: "24h", ttl: 24 * time.Hour},
+	{name: "6h", ttl: 6 * time.Hour},
+	{name: "1h", ttl: time.Hour},
+	{name: "10m", ttl: 10 * time.Minute},
+	{name: "1m", ttl: time.Minute},
+	{name: "10s", ttl: 10 * time.Second},
+}
+
+func (d scheduleDelay) queueName() string {
+	return "scheduled_trip_delay_" + d.name
+}
+
+func (d scheduleDelay) routingKey() string {
+	return "trip.delay." + d.name
+}
+
+// ScheduledTripStore records cancelled scheduled trips until they are dropped.
+// Cancellations must outlive restarts of the scheduler, since trips keep waiting in the delay queues,
+// so production deployments need a persistent store shared by every scheduler instance.
+type ScheduledTripStore interface {
+	Cancel(ctx context.Context, tripID string) error
+	IsCancelled(ctx context.Context, tripID string) (bool, error)
+	// Remove forgets a cancelled trip once it has been dropped.
+	Remove(ctx context.Context, tripID string) error
+}
+
+// MemoryScheduledTripStore is an in-memory ScheduledTripStore for tests and local development.
+// It loses cancellations on restart, after which cancelled trips are dispatched.
+type MemoryScheduledTripStore struct {
+	mu        sync.RWMutex
+	cancelled map[string]bool
+}
+
+// NewMemoryScheduledTripStore creates a new in-memory ScheduledTripStore.
+func NewMemoryScheduledTripStore() *MemoryScheduledTripStore {
+	return &MemoryScheduledTripStore{
+		cancelled: make(map[string]bool),
+	}
+}
+
+// Cancel marks the trip as cancelled.
+func (s *MemoryScheduledTripStore) Cancel(_ context.Context, tripID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancelled[tripID] = true
+	return nil
+}
+
+// IsCancelled reports whether the trip has been cancelled.
+func (s *MemoryScheduledTripStore) IsCancelled(_ context.Context, tripID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cancelled[tripID], nil
+}
+
+// Remove forgets the trip.
+func (s *MemoryScheduledTripStore) Remove(_ context.Context, tripID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cancelled, tripID)
+	return nil
+}
+
+// Scheduler emits trip.event.created for scheduled trips shortly before their pickup time.
+// Trips wait in TTL based delay queues on the trip exchange; the scheduler consumes
+// events.ScheduledTripDispatchQueue as a MessageHandler and either dispatches a trip or delays it again.
+type Scheduler struct {
+	publisher *Publisher
+	store     ScheduledTripStore
+	leadTime  time.Duration
+}
+
+// NewScheduler creates a new Scheduler that dispatches trips leadTime before their pickup time.
+// The store is required; see ScheduledTripStore.
+func NewScheduler(publisher *Publisher, store ScheduledTripStore, leadTime time.Duration) *Scheduler {
+	return &Scheduler{
+		publisher: publisher,
+		store:     store,
+		leadTime:  leadTime,
+	}
+}
+
+// Schedule publishes trip.event.scheduled and delays the trip until its dispatch time.
+// The trip must have an ID; it is not modified.
+func (s *Scheduler) Schedule(ctx context.Context, t *trip.Trip, pickupTime time.Time) error {
+	if t.GetId() == "" {
+		return errors.New("failed to schedule trip: trip ID is required")
+	}
+
+	t = proto.Clone(t).(*trip.Trip)
+	t.ScheduledPickupTime = timestamppb.New(pickupTime)
+
+	data := events.TripScheduledData{
+		Trip:                t,
+		ScheduledPickupTime: pickupTime,
+		DispatchAt:          pickupTime.Add(-s.leadTime),
+	}
+
+	if err := s.publish(ctx, events.TripEventScheduled, t.GetUserID(), data); err != nil {
+		return err
+	}
+
+	return s.delay(ctx, data)
+}
+
+// Cancel cancels a pending scheduled trip. It is dropped the next time it leaves a delay queue.
+func (s *Scheduler) Cancel(ctx context.Context, tripID string) error {
+	if err := s.store.Cancel(ctx, tripID); err != nil {
+		return fmt.Errorf("failed to cancel scheduled trip: %v", err)
+	}
+
+	return nil
+}
+
+// Handle dispatches or re-delays a scheduled trip that left a delay queue.
+func (s *Scheduler) Handle(ctx context.Context, msg amqp.Delivery) error {
+	var message events.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &message); err != nil {
+		return fmt.Errorf("failed to unmarshal message: %v", err)
+	}
+
+	var data events.TripScheduledData
+	if err := json.Unmarshal(message.Data, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal scheduled trip: %v", err)
+	}
+
+	tripID := data.Trip.GetId()
+	if tripID == "" {
+		return errors.New("failed to handle scheduled trip: trip ID is required")
+	}
+
+	cancelled, err := s.store.IsCancelled(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("failed to check scheduled trip: %v", err)
+	}
+	if cancelled {
+		s.publisher.rmq.log().InfoContext(ctx, "Dropping cancelled scheduled trip", "trip_id", tripID)
+		// The trip is already dropped, so a failure only leaves a stale entry behind
+		if err := s.store.Remove(ctx, tripID); err != nil {
+			s.publisher.rmq.log().ErrorContext(ctx, "Failed to remove cancelled scheduled trip",
+				"trip_id", tripID,
+				"error", err,
+			)
+		}
+		return nil
+	}
+
+	return s.delay(ctx, data)
+}
+
+// delay publishes the trip to the longest delay queue that does not overshoot its dispatch time.
+func (s *Scheduler) delay(ctx context.Context, data events.TripScheduledData) error {
+	ownerID := data.Trip.GetUserID()
+
+	delay, ok := nextDelay(time.Until(data.DispatchAt))
+	if !ok {
+		return s.publish(ctx, events.TripEventCreated, ownerID, events.TripEventData{Trip: data.Trip})
+	}
+
+	return s.publish(ctx, delay.routingKey(), ownerID, data)
+}
+
+func (s *Scheduler) publish(ctx context.Context, routingKey, ownerID string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data: %v", err)
+	}
+
+	return s.publisher.PublishMessage(ctx, routingKey, events.AmqpMessage{
+		OwnerID: ownerID,
+		Data:    raw,
+	})
+}
+
+// nextDelay returns the longest delay not exceeding remaining. It reports false if the trip is due.
+func nextDelay(remaining time.Duration) (scheduleDelay, bool) {
+	for _, delay := range scheduleDelays {
+		if delay.ttl <= remaining {
+			return delay, true
+		}
+	}
+
+	return scheduleDelay{}, false
+}
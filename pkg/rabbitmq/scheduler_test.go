@@ -0,0 +1,119 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/ride4Low/contracts/events"
+	"github.com/ride4Low/contracts/proto/trip"
+)
+
+func TestSchedulerDropsAndForgetsCancelledTrips(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryScheduledTripStore()
+	scheduler := NewScheduler(NewPublisher(&RabbitMQ{}), store, 15*time.Minute)
+
+	if err := scheduler.Cancel(ctx, "trip-1"); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+
+	data, err := json.Marshal(events.TripScheduledData{
+		Trip:       &trip.Trip{Id: "trip-1", UserID: "user-1"},
+		DispatchAt: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	body, err := json.Marshal(events.AmqpMessage{OwnerID: "user-1", Data: data})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	// A cancelled trip is dropped without publishing, which would fail without a channel
+	if err := scheduler.Handle(ctx, amqp.Delivery{Body: body}); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if cancelled, _ := store.IsCancelled(ctx, "trip-1"); cancelled {
+		t.Error("cancelled trip is still stored after being dropped")
+	}
+}
+
+func TestNextDelay(t *testing.T) {
+	tests := []struct {
+		remaining time.Duration
+		want      string
+		wantOK    bool
+	}{
+		{remaining: 48 * time.Hour, want: "24h", wantOK: true},
+		{remaining: 5 * time.Hour, want: "1h", wantOK: true},
+		{remaining: 90 * time.Second, want: "1m", wantOK: true},
+		{remaining: 10 * time.Second, want: "10s", wantOK: true},
+		{remaining: 9 * time.Second, wantOK: false},
+		{remaining: -time.Minute, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		delay, ok := nextDelay(tt.remaining)
+		if ok != tt.wantOK || delay.name != tt.want {
+			t.Errorf("nextDelay(%v) = %q, %v, want %q, %v", tt.remaining, delay.name, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSchedulePublishesScheduledAndDelays(t *testing.T) {
+	var routingKeys []string
+	publisher := NewPublisher(&RabbitMQ{})
+	publisher.publish = func(_ context.Context, exchange, routingKey string, _ amqp.Publishing) error {
+		if exchange != TripExchange {
+			t.Errorf("exchange = %q, want %q", exchange, TripExchange)
+		}
+		routingKeys = append(routingKeys, routingKey)
+		return nil
+	}
+	scheduler := NewScheduler(publisher, NewMemoryScheduledTripStore(), 15*time.Minute)
+
+	tr := &trip.Trip{Id: "trip-1", UserID: "user-1"}
+	if err := scheduler.Schedule(context.Background(), tr, time.Now().Add(2*time.Hour)); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+
+	// The dispatch time is 1h45m away, so the trip waits in the 1h delay queue first
+	want := []string{events.TripEventScheduled, "trip.delay.1h"}
+	if !slices.Equal(routingKeys, want) {
+		t.Errorf("routing keys = %q, want %q", routingKeys, want)
+	}
+	if tr.GetScheduledPickupTime() != nil {
+		t.Error("Schedule() modified the caller's trip")
+	}
+}
+
+func TestSchedulerRejectsTripsWithoutID(t *testing.T) {
+	publisher := NewPublisher(&RabbitMQ{})
+	publisher.publish = func(context.Context, string, string, amqp.Publishing) error {
+		t.Error("published a trip without an ID")
+		return nil
+	}
+	scheduler := NewScheduler(publisher, NewMemoryScheduledTripStore(), 15*time.Minute)
+	ctx := context.Background()
+
+	for _, tr := range []*trip.Trip{nil, {UserID: "user-1"}} {
+		if err := scheduler.Schedule(ctx, tr, time.Now().Add(time.Hour)); err == nil {
+			t.Errorf("Schedule(%v) error = nil, want an error", tr)
+		}
+	}
+
+	for _, data := range []string{`{}`, `{"trip":{"userID":"user-1"}}`} {
+		body, err := json.Marshal(events.AmqpMessage{OwnerID: "user-1", Data: json.RawMessage(data)})
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		if err := scheduler.Handle(ctx, amqp.Delivery{Body: body}); err == nil {
+			t.Errorf("Handle(%s) error = nil, want an error", data)
+		}
+	}
+}
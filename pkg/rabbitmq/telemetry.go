@@ -0,0 +1,101 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bytedance/sonic"
+	"github.com/ride4Low/contracts/events"
+	telemetry "github.com/ride4Low/contracts/pkg/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const instrumentationName = "rabbitmq"
+
+// instruments holds the RabbitMQ metrics.
+type instruments struct {
+	publishDuration metric.Float64Histogram
+	processDuration metric.Float64Histogram
+	retries         metric.Int64Counter
+	rejected        metric.Int64Counter
+	inFlight        metric.Int64UpDownCounter
+}
+
+// newInstruments creates the instruments from the given meter provider.
+func newInstruments(mp metric.MeterProvider) *instruments {
+	meter := mp.Meter(instrumentationName)
+
+	var errs []error
+	record := func(err error) {
+		errs = append(errs, err)
+	}
+
+	inst := &instruments{}
+	var err error
+
+	inst.publishDuration, err = meter.Float64Histogram("messaging.client.operation.duration",
+		metric.WithDescription("Duration of publishing a message."),
+		metric.WithUnit("s"),
+	)
+	record(err)
+
+	inst.processDuration, err = meter.Float64Histogram("messaging.process.duration",
+		metric.WithDescription("Duration of processing a message, including retries."),
+		metric.WithUnit("s"),
+	)
+	record(err)
+
+	inst.retries, err = meter.Int64Counter("messaging.rabbitmq.process.retries",
+		metric.WithDescription("Number of times a message handler was retried."),
+		metric.WithUnit("{retry}"),
+	)
+	record(err)
+
+	inst.rejected, err = meter.Int64Counter("messaging.rabbitmq.rejected.messages",
+		metric.WithDescription("Number of messages rejected to the dead letter exchange."),
+		metric.WithUnit("{message}"),
+	)
+	record(err)
+
+	inst.inFlight, err = meter.Int64UpDownCounter("messaging.rabbitmq.in_flight.messages",
+		metric.WithDescription("Number of messages being processed."),
+		metric.WithUnit("{message}"),
+	)
+	record(err)
+
+	if err := errors.Join(errs...); err != nil {
+		otel.Handle(err)
+	}
+
+	return inst
+}
+
+type conversationIDKey struct{}
+
+// contextWithConversationID stores the conversation ID of the message being processed,
+// so that messages published while handling it belong to the same conversation.
+func contextWithConversationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, conversationIDKey{}, id)
+}
+
+func conversationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(conversationIDKey{}).(string)
+	return id
+}
+
+// contextWithEventIDs puts the trip, user and driver IDs referenced by the message into the context's baggage,
+// so that they are set on the consume span and propagated to the messages published while handling it.
+func contextWithEventIDs(ctx context.Context, body []byte) context.Context {
+	var message events.AmqpMessage
+	if err := sonic.Unmarshal(body, &message); err != nil {
+		return ctx
+	}
+
+	ids := events.ExtractIDs(message.Data)
+	ctx = telemetry.WithTripID(ctx, ids.TripID)
+	ctx = telemetry.WithUserID(ctx, ids.UserID)
+	ctx = telemetry.WithDriverID(ctx, ids.DriverID)
+
+	return ctx
+}
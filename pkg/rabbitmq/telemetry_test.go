@@ -0,0 +1,42 @@
+package rabbitmq
+
+import (
+	"context"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type handlerFunc func(context.Context, amqp.Delivery) error
+
+func (f handlerFunc) Handle(ctx context.Context, msg amqp.Delivery) error {
+	return f(ctx, msg)
+}
+
+func TestMetricsUseConnectionMeterProvider(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	rmq := &RabbitMQ{}
+	WithMeterProvider(mp)(rmq)
+
+	consumer := NewConsumer(rmq, handlerFunc(func(context.Context, amqp.Delivery) error { return nil }))
+	consumer.handle(context.Background(), "payment_queue", amqp.Delivery{RoutingKey: "trip.event.completed"})
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "messaging.process.duration" {
+				return
+			}
+		}
+	}
+	t.Error("messaging.process.duration not recorded with the connection's meter provider")
+}
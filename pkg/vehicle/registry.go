@@ -0,0 +1,87 @@
+package vehicle
+
+import (
+	"slices"
+
+	"github.com/ride4Low/contracts/proto/driver"
+	"github.com/ride4Low/contracts/proto/trip"
+)
+
+// Registry holds the compatibility rules between driver and trip packages.
+type Registry struct {
+	compatible map[PackageSlug][]PackageSlug
+}
+
+// DefaultRegistry lets every driver take trips of their own package, and luxury drivers take sedan trips.
+var DefaultRegistry = NewRegistry(map[PackageSlug][]PackageSlug{
+	Luxury: {Sedan},
+})
+
+// NewRegistry creates a Registry where a driver of each key package may also take trips of the listed packages.
+// A driver may always take trips of their own package.
+func NewRegistry(compatible map[PackageSlug][]PackageSlug) *Registry {
+	rules := make(map[PackageSlug][]PackageSlug, len(packageSlugs))
+	for _, slug := range packageSlugs {
+		rules[slug] = []PackageSlug{slug}
+	}
+
+	for driverSlug, tripSlugs := range compatible {
+		for _, tripSlug := range tripSlugs {
+			if !slices.Contains(rules[driverSlug], tripSlug) {
+				rules[driverSlug] = append(rules[driverSlug], tripSlug)
+			}
+		}
+	}
+
+	return &Registry{compatible: rules}
+}
+
+// CanServe reports whether a driver registered with driverSlug may take a trip booked as tripSlug.
+func (r *Registry) CanServe(driverSlug, tripSlug PackageSlug) bool {
+	if !driverSlug.Valid() || !tripSlug.Valid() {
+		return false
+	}
+
+	return slices.Contains(r.compatible[driverSlug], tripSlug)
+}
+
+// Servable returns the trip packages a driver registered with driverSlug may take.
+func (r *Registry) Servable(driverSlug PackageSlug) []PackageSlug {
+	return slices.Clone(r.compatible[driverSlug])
+}
+
+// IsEligible reports whether d may be offered a trip priced with fare.
+func (r *Registry) IsEligible(d *driver.Driver, fare *trip.RideFare) bool {
+	if d == nil || fare == nil {
+		return false
+	}
+
+	return r.CanServe(FromDriverMessage(d), FromTripMessage(fare))
+}
+
+// EligibleDrivers returns the drivers that may be offered a trip priced with fare, in their original order.
+func (r *Registry) EligibleDrivers(drivers []*driver.Driver, fare *trip.RideFare) []*driver.Driver {
+	eligible := make([]*driver.Driver, 0, len(drivers))
+	for _, d := range drivers {
+		if r.IsEligible(d, fare) {
+			eligible = append(eligible, d)
+		}
+	}
+
+	return eligible
+}
+
+// CanServe reports whether driverSlug may take tripSlug trips according to DefaultRegistry.
+func CanServe(driverSlug, tripSlug PackageSlug) bool {
+	return DefaultRegistry.CanServe(driverSlug, tripSlug)
+}
+
+// IsEligible reports whether d may be offered a trip priced with fare according to DefaultRegistry.
+func IsEligible(d *driver.Driver, fare *trip.RideFare) bool {
+	return DefaultRegistry.IsEligible(d, fare)
+}
+
+// EligibleDrivers filters drivers that may be offered a trip priced with fare according to DefaultRegistry.
+func EligibleDrivers(drivers []*driver.Driver, fare *trip.RideFare) []*driver.Driver {
+	return DefaultRegistry.EligibleDrivers(drivers, fare)
+}
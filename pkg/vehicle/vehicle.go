@@ -0,0 +1,136 @@
+/*
+Package vehicle provides the typed registry of vehicle packages shared by the trip and driver services.
+*/
+package vehicle
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ride4Low/contracts/proto/driver"
+	"github.com/ride4Low/contracts/proto/trip"
+)
+
+// ErrInvalidPackageSlug is returned when a string does not name a known vehicle package.
+var ErrInvalidPackageSlug = errors.New("invalid package slug")
+
+// PackageSlug identifies a vehicle package. Its string form is what is stored in MongoDB.
+type PackageSlug string
+
+// Known vehicle packages
+const (
+	Sedan  PackageSlug = "sedan"
+	Luxury PackageSlug = "luxury"
+	Van    PackageSlug = "van"
+)
+
+var packageSlugs = []PackageSlug{Sedan, Luxury, Van}
+
+var tripSlugs = map[PackageSlug]trip.PackageSlug{
+	Sedan:  trip.PackageSlug_PACKAGE_SLUG_SEDAN,
+	Luxury: trip.PackageSlug_PACKAGE_SLUG_LUXURY,
+	Van:    trip.PackageSlug_PACKAGE_SLUG_VAN,
+}
+
+var driverSlugs = map[PackageSlug]driver.PackageSlug{
+	Sedan:  driver.PackageSlug_PACKAGE_SLUG_SEDAN,
+	Luxury: driver.PackageSlug_PACKAGE_SLUG_LUXURY,
+	Van:    driver.PackageSlug_PACKAGE_SLUG_VAN,
+}
+
+// All returns every known vehicle package.
+func All() []PackageSlug {
+	slugs := make([]PackageSlug, len(packageSlugs))
+	copy(slugs, packageSlugs)
+	return slugs
+}
+
+// Parse returns the PackageSlug for s, ignoring case and surrounding whitespace.
+func Parse(s string) (PackageSlug, error) {
+	slug := PackageSlug(strings.ToLower(strings.TrimSpace(s)))
+	if !slug.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPackageSlug, s)
+	}
+
+	return slug, nil
+}
+
+// Valid reports whether s is a known vehicle package.
+func (s PackageSlug) Valid() bool {
+	_, ok := tripSlugs[s]
+	return ok
+}
+
+// String returns the slug as stored in MongoDB.
+func (s PackageSlug) String() string {
+	return string(s)
+}
+
+// TripProto returns the trip.PackageSlug for s, or PACKAGE_SLUG_UNSPECIFIED if s is unknown.
+func (s PackageSlug) TripProto() trip.PackageSlug {
+	return tripSlugs[s]
+}
+
+// DriverProto returns the driver.PackageSlug for s, or PACKAGE_SLUG_UNSPECIFIED if s is unknown.
+func (s PackageSlug) DriverProto() driver.PackageSlug {
+	return driverSlugs[s]
+}
+
+// FromTripProto returns the PackageSlug for p, or an empty slug if p is unspecified or unknown.
+func FromTripProto(p trip.PackageSlug) PackageSlug {
+	for slug, tripSlug := range tripSlugs {
+		if tripSlug == p {
+			return slug
+		}
+	}
+
+	return ""
+}
+
+// FromDriverProto returns the PackageSlug for p, or an empty slug if p is unspecified or unknown.
+func FromDriverProto(p driver.PackageSlug) PackageSlug {
+	for slug, driverSlug := range driverSlugs {
+		if driverSlug == p {
+			return slug
+		}
+	}
+
+	return ""
+}
+
+// TripMessage is a trip message carrying a package both as the vehiclePackage enum
+// and as the deprecated packageSlug string, such as trip.RideFare.
+type TripMessage interface {
+	GetVehiclePackage() trip.PackageSlug
+	GetPackageSlug() string
+}
+
+// DriverMessage is a driver message carrying a package both as the vehiclePackage enum
+// and as the deprecated packageSlug string, such as driver.Driver and driver.RegisterDriverRequest.
+type DriverMessage interface {
+	GetVehiclePackage() driver.PackageSlug
+	GetPackageSlug() string
+}
+
+// FromTripMessage returns the package of m, falling back to the deprecated string for older senders.
+// It returns an empty slug if neither field names a known package.
+func FromTripMessage(m TripMessage) PackageSlug {
+	if slug := FromTripProto(m.GetVehiclePackage()); slug != "" {
+		return slug
+	}
+
+	slug, _ := Parse(m.GetPackageSlug())
+	return slug
+}
+
+// FromDriverMessage returns the package of m, falling back to the deprecated string for older senders.
+// It returns an empty slug if neither field names a known package.
+func FromDriverMessage(m DriverMessage) PackageSlug {
+	if slug := FromDriverProto(m.GetVehiclePackage()); slug != "" {
+		return slug
+	}
+
+	slug, _ := Parse(m.GetPackageSlug())
+	return slug
+}
@@ -0,0 +1,88 @@
+package vehicle
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ride4Low/contracts/proto/driver"
+	"github.com/ride4Low/contracts/proto/trip"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFromMessageFallsBackToLegacySlug(t *testing.T) {
+	tests := []struct {
+		name string
+		got  PackageSlug
+		want PackageSlug
+	}{
+		{"driver enum", FromDriverMessage(&driver.Driver{VehiclePackage: driver.PackageSlug_PACKAGE_SLUG_VAN, PackageSlug: "sedan"}), Van},
+		{"driver legacy string", FromDriverMessage(&driver.Driver{PackageSlug: "Sedan"}), Sedan},
+		{"driver unknown", FromDriverMessage(&driver.Driver{PackageSlug: "bike"}), ""},
+		{"register legacy string", FromDriverMessage(&driver.RegisterDriverRequest{PackageSlug: "luxury"}), Luxury},
+		{"fare enum", FromTripMessage(&trip.RideFare{VehiclePackage: trip.PackageSlug_PACKAGE_SLUG_LUXURY}), Luxury},
+		{"fare legacy string", FromTripMessage(&trip.RideFare{PackageSlug: "van"}), Van},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// Messages encoded before the enum was introduced carry the slug as a string in the original field.
+func TestLegacyWireFormat(t *testing.T) {
+	var legacy []byte
+	legacy = protowire.AppendTag(legacy, 1, protowire.BytesType)
+	legacy = protowire.AppendString(legacy, "driver-1")
+	legacy = protowire.AppendTag(legacy, 6, protowire.BytesType)
+	legacy = protowire.AppendString(legacy, "sedan")
+
+	var d driver.Driver
+	if err := proto.Unmarshal(legacy, &d); err != nil {
+		t.Fatalf("proto.Unmarshal() error = %v", err)
+	}
+	if got := FromDriverMessage(&d); got != Sedan {
+		t.Errorf("FromDriverMessage() = %q, want %q", got, Sedan)
+	}
+}
+
+func TestLegacyJSON(t *testing.T) {
+	var d driver.Driver
+	if err := json.Unmarshal([]byte(`{"id":"driver-1","packageSlug":"sedan"}`), &d); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got := FromDriverMessage(&d); got != Sedan {
+		t.Errorf("FromDriverMessage() = %q, want %q", got, Sedan)
+	}
+
+	encoded, err := json.Marshal(&driver.Driver{PackageSlug: Sedan.String(), VehiclePackage: Sedan.DriverProto()})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if fields["packageSlug"] != "sedan" {
+		t.Errorf("packageSlug = %v, want \"sedan\"", fields["packageSlug"])
+	}
+}
+
+func TestIsEligible(t *testing.T) {
+	luxuryDriver := &driver.Driver{PackageSlug: "luxury"}
+	sedanDriver := &driver.Driver{VehiclePackage: driver.PackageSlug_PACKAGE_SLUG_SEDAN}
+	sedanFare := &trip.RideFare{VehiclePackage: trip.PackageSlug_PACKAGE_SLUG_SEDAN}
+	luxuryFare := &trip.RideFare{PackageSlug: "luxury"}
+
+	if !IsEligible(luxuryDriver, sedanFare) {
+		t.Error("luxury driver is not eligible for a sedan fare")
+	}
+	if IsEligible(sedanDriver, luxuryFare) {
+		t.Error("sedan driver is eligible for a luxury fare")
+	}
+}
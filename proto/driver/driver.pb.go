@@ -9,6 +9,8 @@ package driver
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,29 +23,1008 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DriverAvailability int32
+
+const (
+	DriverAvailability_DRIVER_AVAILABILITY_UNSPECIFIED DriverAvailability = 0
+	DriverAvailability_DRIVER_AVAILABILITY_OFFLINE     DriverAvailability = 1
+	DriverAvailability_DRIVER_AVAILABILITY_ONLINE      DriverAvailability = 2
+	DriverAvailability_DRIVER_AVAILABILITY_ON_TRIP     DriverAvailability = 3
+)
+
+// Enum value maps for DriverAvailability.
+var (
+	DriverAvailability_name = map[int32]string{
+		0: "DRIVER_AVAILABILITY_UNSPECIFIED",
+		1: "DRIVER_AVAILABILITY_OFFLINE",
+		2: "DRIVER_AVAILABILITY_ONLINE",
+		3: "DRIVER_AVAILABILITY_ON_TRIP",
+	}
+	DriverAvailability_value = map[string]int32{
+		"DRIVER_AVAILABILITY_UNSPECIFIED": 0,
+		"DRIVER_AVAILABILITY_OFFLINE":     1,
+		"DRIVER_AVAILABILITY_ONLINE":      2,
+		"DRIVER_AVAILABILITY_ON_TRIP":     3,
+	}
+)
+
+func (x DriverAvailability) Enum() *DriverAvailability {
+	p := new(DriverAvailability)
+	*p = x
+	return p
+}
+
+func (x DriverAvailability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverAvailability) Descriptor() protoreflect.EnumDescriptor {
+	return file_driver_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverAvailability) Type() protoreflect.EnumType {
+	return &file_driver_proto_enumTypes[0]
+}
+
+func (x DriverAvailability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriverAvailability.Descriptor instead.
+func (DriverAvailability) EnumDescriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{0}
+}
+
+type PackageSlug int32
+
+const (
+	PackageSlug_PACKAGE_SLUG_UNSPECIFIED PackageSlug = 0
+	PackageSlug_PACKAGE_SLUG_SEDAN       PackageSlug = 1
+	PackageSlug_PACKAGE_SLUG_LUXURY      PackageSlug = 2
+	PackageSlug_PACKAGE_SLUG_VAN         PackageSlug = 3
+)
+
+// Enum value maps for PackageSlug.
+var (
+	PackageSlug_name = map[int32]string{
+		0: "PACKAGE_SLUG_UNSPECIFIED",
+		1: "PACKAGE_SLUG_SEDAN",
+		2: "PACKAGE_SLUG_LUXURY",
+		3: "PACKAGE_SLUG_VAN",
+	}
+	PackageSlug_value = map[string]int32{
+		"PACKAGE_SLUG_UNSPECIFIED": 0,
+		"PACKAGE_SLUG_SEDAN":       1,
+		"PACKAGE_SLUG_LUXURY":      2,
+		"PACKAGE_SLUG_VAN":         3,
+	}
+)
+
+func (x PackageSlug) Enum() *PackageSlug {
+	p := new(PackageSlug)
+	*p = x
+	return p
+}
+
+func (x PackageSlug) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageSlug) Descriptor() protoreflect.EnumDescriptor {
+	return file_driver_proto_enumTypes[1].Descriptor()
+}
+
+func (PackageSlug) Type() protoreflect.EnumType {
+	return &file_driver_proto_enumTypes[1]
+}
+
+func (x PackageSlug) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageSlug.Descriptor instead.
+func (PackageSlug) EnumDescriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{1}
+}
+
 type RegisterDriverRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DriverID string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	// Deprecated: use vehiclePackage. Senders still set the lowercase slug (e.g. "sedan") for older readers.
+	//
+	// Deprecated: Marked as deprecated in driver.proto.
+	PackageSlug    string      `protobuf:"bytes,2,opt,name=packageSlug,proto3" json:"packageSlug,omitempty"`
+	VehiclePackage PackageSlug `protobuf:"varint,3,opt,name=vehiclePackage,proto3,enum=driver.PackageSlug" json:"vehiclePackage,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterDriverRequest) Reset() {
+	*x = RegisterDriverRequest{}
+	mi := &file_driver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDriverRequest) ProtoMessage() {}
+
+func (x *RegisterDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDriverRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterDriverRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in driver.proto.
+func (x *RegisterDriverRequest) GetPackageSlug() string {
+	if x != nil {
+		return x.PackageSlug
+	}
+	return ""
+}
+
+func (x *RegisterDriverRequest) GetVehiclePackage() PackageSlug {
+	if x != nil {
+		return x.VehiclePackage
+	}
+	return PackageSlug_PACKAGE_SLUG_UNSPECIFIED
+}
+
+type RegisterDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDriverResponse) Reset() {
+	*x = RegisterDriverResponse{}
+	mi := &file_driver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDriverResponse) ProtoMessage() {}
+
+func (x *RegisterDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDriverResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type UnregisterDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDriverRequest) Reset() {
+	*x = UnregisterDriverRequest{}
+	mi := &file_driver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDriverRequest) ProtoMessage() {}
+
+func (x *UnregisterDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDriverRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnregisterDriverRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type UnregisterDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDriverResponse) Reset() {
+	*x = UnregisterDriverResponse{}
+	mi := &file_driver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDriverResponse) ProtoMessage() {}
+
+func (x *UnregisterDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDriverResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnregisterDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type SetAvailabilityRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DriverID     string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	Availability DriverAvailability     `protobuf:"varint,2,opt,name=availability,proto3,enum=driver.DriverAvailability" json:"availability,omitempty"`
+	// Trip the driver is on. Required when availability is DRIVER_AVAILABILITY_ON_TRIP.
+	TripID        string `protobuf:"bytes,3,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAvailabilityRequest) Reset() {
+	*x = SetAvailabilityRequest{}
+	mi := &file_driver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetAvailabilityRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *SetAvailabilityRequest) GetAvailability() DriverAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return DriverAvailability_DRIVER_AVAILABILITY_UNSPECIFIED
+}
+
+func (x *SetAvailabilityRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+type SetAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAvailabilityResponse) Reset() {
+	*x = SetAvailabilityResponse{}
+	mi := &file_driver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetAvailabilityResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type UpdateVehicleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DriverID       string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	Vehicle        *Vehicle               `protobuf:"bytes,2,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	VehiclePackage PackageSlug            `protobuf:"varint,3,opt,name=vehiclePackage,proto3,enum=driver.PackageSlug" json:"vehiclePackage,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_driver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateVehicleRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *UpdateVehicleRequest) GetVehiclePackage() PackageSlug {
+	if x != nil {
+		return x.VehiclePackage
+	}
+	return PackageSlug_PACKAGE_SLUG_UNSPECIFIED
+}
+
+type UpdateVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleResponse) Reset() {
+	*x = UpdateVehicleResponse{}
+	mi := &file_driver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleResponse) ProtoMessage() {}
+
+func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateVehicleResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+// Unset fields are left unchanged.
+type UpdateProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DriverID       string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ProfilePicture *string                `protobuf:"bytes,3,opt,name=profilePicture,proto3,oneof" json:"profilePicture,omitempty"`
+	PhoneNumber    *string                `protobuf:"bytes,4,opt,name=phoneNumber,proto3,oneof" json:"phoneNumber,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_driver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateProfileRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetProfilePicture() string {
+	if x != nil && x.ProfilePicture != nil {
+		return *x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhoneNumber() string {
+	if x != nil && x.PhoneNumber != nil {
+		return *x.PhoneNumber
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_driver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProfileResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type GetDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverID      string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverRequest) Reset() {
+	*x = GetDriverRequest{}
+	mi := &file_driver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverRequest) ProtoMessage() {}
+
+func (x *GetDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDriverRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type GetDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverResponse) Reset() {
+	*x = GetDriverResponse{}
+	mi := &file_driver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverResponse) ProtoMessage() {}
+
+func (x *GetDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type ListNearbyDriversRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Location       *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	RadiusInMeters float64                `protobuf:"fixed64,2,opt,name=radiusInMeters,proto3" json:"radiusInMeters,omitempty"`
+	// Only drivers registered with one of these packages are returned. Empty means all packages.
+	PackageSlugs []PackageSlug `protobuf:"varint,3,rep,packed,name=packageSlugs,proto3,enum=driver.PackageSlug" json:"packageSlugs,omitempty"`
+	// Only online drivers are returned unless includeUnavailable is set.
+	IncludeUnavailable bool  `protobuf:"varint,4,opt,name=includeUnavailable,proto3" json:"includeUnavailable,omitempty"`
+	Limit              int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListNearbyDriversRequest) Reset() {
+	*x = ListNearbyDriversRequest{}
+	mi := &file_driver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyDriversRequest) ProtoMessage() {}
+
+func (x *ListNearbyDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyDriversRequest.ProtoReflect.Descriptor instead.
+func (*ListNearbyDriversRequest) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListNearbyDriversRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListNearbyDriversRequest) GetRadiusInMeters() float64 {
+	if x != nil {
+		return x.RadiusInMeters
+	}
+	return 0
+}
+
+func (x *ListNearbyDriversRequest) GetPackageSlugs() []PackageSlug {
+	if x != nil {
+		return x.PackageSlugs
+	}
+	return nil
+}
+
+func (x *ListNearbyDriversRequest) GetIncludeUnavailable() bool {
+	if x != nil {
+		return x.IncludeUnavailable
+	}
+	return false
+}
+
+func (x *ListNearbyDriversRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNearbyDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*NearbyDriver        `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyDriversResponse) Reset() {
+	*x = ListNearbyDriversResponse{}
+	mi := &file_driver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyDriversResponse) ProtoMessage() {}
+
+func (x *ListNearbyDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyDriversResponse.ProtoReflect.Descriptor instead.
+func (*ListNearbyDriversResponse) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNearbyDriversResponse) GetDrivers() []*NearbyDriver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+type NearbyDriver struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Driver           *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	DistanceInMeters float64                `protobuf:"fixed64,2,opt,name=distanceInMeters,proto3" json:"distanceInMeters,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NearbyDriver) Reset() {
+	*x = NearbyDriver{}
+	mi := &file_driver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyDriver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyDriver) ProtoMessage() {}
+
+func (x *NearbyDriver) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyDriver.ProtoReflect.Descriptor instead.
+func (*NearbyDriver) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NearbyDriver) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *NearbyDriver) GetDistanceInMeters() float64 {
+	if x != nil {
+		return x.DistanceInMeters
+	}
+	return 0
+}
+
+type LocationUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Monotonically increasing per driver, including across client restarts; used to acknowledge and resume the stream.
+	Sequence uint64    `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Location *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Degrees clockwise from true north.
+	Heading                float64                `protobuf:"fixed64,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	SpeedInMetersPerSecond float64                `protobuf:"fixed64,4,opt,name=speedInMetersPerSecond,proto3" json:"speedInMetersPerSecond,omitempty"`
+	AccuracyInMeters       float64                `protobuf:"fixed64,5,opt,name=accuracyInMeters,proto3" json:"accuracyInMeters,omitempty"`
+	Timestamp              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Trip the driver is on, if any.
+	TripID        string `protobuf:"bytes,7,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationUpdate) Reset() {
+	*x = LocationUpdate{}
+	mi := &file_driver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationUpdate) ProtoMessage() {}
+
+func (x *LocationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationUpdate.ProtoReflect.Descriptor instead.
+func (*LocationUpdate) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LocationUpdate) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationUpdate) GetHeading() float64 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetSpeedInMetersPerSecond() float64 {
+	if x != nil {
+		return x.SpeedInMetersPerSecond
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetAccuracyInMeters() float64 {
+	if x != nil {
+		return x.AccuracyInMeters
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LocationUpdate) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+type LocationBatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DriverID      string                 `protobuf:"bytes,1,opt,name=driverID,proto3" json:"driverID,omitempty"`
-	PackageSlug   string                 `protobuf:"bytes,2,opt,name=packageSlug,proto3" json:"packageSlug,omitempty"`
+	Updates       []*LocationUpdate      `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterDriverRequest) Reset() {
-	*x = RegisterDriverRequest{}
-	mi := &file_driver_proto_msgTypes[0]
+func (x *LocationBatch) Reset() {
+	*x = LocationBatch{}
+	mi := &file_driver_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterDriverRequest) String() string {
+func (x *LocationBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDriverRequest) ProtoMessage() {}
+func (*LocationBatch) ProtoMessage() {}
 
-func (x *RegisterDriverRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_proto_msgTypes[0]
+func (x *LocationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,47 +1035,114 @@ func (x *RegisterDriverRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDriverRequest.ProtoReflect.Descriptor instead.
-func (*RegisterDriverRequest) Descriptor() ([]byte, []int) {
-	return file_driver_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LocationBatch.ProtoReflect.Descriptor instead.
+func (*LocationBatch) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RegisterDriverRequest) GetDriverID() string {
+func (x *LocationBatch) GetDriverID() string {
 	if x != nil {
 		return x.DriverID
 	}
 	return ""
 }
 
-func (x *RegisterDriverRequest) GetPackageSlug() string {
+func (x *LocationBatch) GetUpdates() []*LocationUpdate {
 	if x != nil {
-		return x.PackageSlug
+		return x.Updates
 	}
-	return ""
+	return nil
 }
 
-type RegisterDriverResponse struct {
+type LocationAck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Every update with a sequence lower than or equal to this one has been processed.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Backpressure hints. Zero values mean the client may keep its current settings.
+	MinSendInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=minSendInterval,proto3" json:"minSendInterval,omitempty"`
+	MaxBatchSize    int32                `protobuf:"varint,3,opt,name=maxBatchSize,proto3" json:"maxBatchSize,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocationAck) Reset() {
+	*x = LocationAck{}
+	mi := &file_driver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationAck) ProtoMessage() {}
+
+func (x *LocationAck) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationAck.ProtoReflect.Descriptor instead.
+func (*LocationAck) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LocationAck) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LocationAck) GetMinSendInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MinSendInterval
+	}
+	return nil
+}
+
+func (x *LocationAck) GetMaxBatchSize() int32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+type Vehicle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Make          string                 `protobuf:"bytes,1,opt,name=make,proto3" json:"make,omitempty"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	CarPlate      string                 `protobuf:"bytes,4,opt,name=carPlate,proto3" json:"carPlate,omitempty"`
+	Year          int32                  `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterDriverResponse) Reset() {
-	*x = RegisterDriverResponse{}
-	mi := &file_driver_proto_msgTypes[1]
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_driver_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterDriverResponse) String() string {
+func (x *Vehicle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDriverResponse) ProtoMessage() {}
+func (*Vehicle) ProtoMessage() {}
 
-func (x *RegisterDriverResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_proto_msgTypes[1]
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,16 +1153,51 @@ func (x *RegisterDriverResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDriverResponse.ProtoReflect.Descriptor instead.
-func (*RegisterDriverResponse) Descriptor() ([]byte, []int) {
-	return file_driver_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_driver_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RegisterDriverResponse) GetDriver() *Driver {
+func (x *Vehicle) GetMake() string {
 	if x != nil {
-		return x.Driver
+		return x.Make
 	}
-	return nil
+	return ""
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Vehicle) GetCarPlate() string {
+	if x != nil {
+		return x.CarPlate
+	}
+	return ""
+}
+
+func (x *Vehicle) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Vehicle) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
 }
 
 type Driver struct {
@@ -124,15 +1207,24 @@ type Driver struct {
 	ProfilePicture string                 `protobuf:"bytes,3,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
 	CarPlate       string                 `protobuf:"bytes,4,opt,name=carPlate,proto3" json:"carPlate,omitempty"`
 	Geohash        string                 `protobuf:"bytes,5,opt,name=geohash,proto3" json:"geohash,omitempty"`
-	PackageSlug    string                 `protobuf:"bytes,6,opt,name=packageSlug,proto3" json:"packageSlug,omitempty"`
-	Location       *Location              `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	// Deprecated: use vehiclePackage. Senders still set the lowercase slug (e.g. "sedan") for older readers.
+	//
+	// Deprecated: Marked as deprecated in driver.proto.
+	PackageSlug    string             `protobuf:"bytes,6,opt,name=packageSlug,proto3" json:"packageSlug,omitempty"`
+	Location       *Location          `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Availability   DriverAvailability `protobuf:"varint,8,opt,name=availability,proto3,enum=driver.DriverAvailability" json:"availability,omitempty"`
+	Vehicle        *Vehicle           `protobuf:"bytes,9,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	PhoneNumber    string             `protobuf:"bytes,10,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Rating         float64            `protobuf:"fixed64,11,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount    int32              `protobuf:"varint,12,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	VehiclePackage PackageSlug        `protobuf:"varint,13,opt,name=vehiclePackage,proto3,enum=driver.PackageSlug" json:"vehiclePackage,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Driver) Reset() {
 	*x = Driver{}
-	mi := &file_driver_proto_msgTypes[2]
+	mi := &file_driver_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -144,7 +1236,7 @@ func (x *Driver) String() string {
 func (*Driver) ProtoMessage() {}
 
 func (x *Driver) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_proto_msgTypes[2]
+	mi := &file_driver_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,7 +1249,7 @@ func (x *Driver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Driver.ProtoReflect.Descriptor instead.
 func (*Driver) Descriptor() ([]byte, []int) {
-	return file_driver_proto_rawDescGZIP(), []int{2}
+	return file_driver_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Driver) GetId() string {
@@ -195,6 +1287,7 @@ func (x *Driver) GetGeohash() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in driver.proto.
 func (x *Driver) GetPackageSlug() string {
 	if x != nil {
 		return x.PackageSlug
@@ -209,6 +1302,48 @@ func (x *Driver) GetLocation() *Location {
 	return nil
 }
 
+func (x *Driver) GetAvailability() DriverAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return DriverAvailability_DRIVER_AVAILABILITY_UNSPECIFIED
+}
+
+func (x *Driver) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *Driver) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Driver) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Driver) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Driver) GetVehiclePackage() PackageSlug {
+	if x != nil {
+		return x.VehiclePackage
+	}
+	return PackageSlug_PACKAGE_SLUG_UNSPECIFIED
+}
+
 type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
@@ -219,7 +1354,7 @@ type Location struct {
 
 func (x *Location) Reset() {
 	*x = Location{}
-	mi := &file_driver_proto_msgTypes[3]
+	mi := &file_driver_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +1366,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_proto_msgTypes[3]
+	mi := &file_driver_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +1379,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_driver_proto_rawDescGZIP(), []int{3}
+	return file_driver_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Location) GetLatitude() float64 {
@@ -265,26 +1400,113 @@ var File_driver_proto protoreflect.FileDescriptor
 
 const file_driver_proto_rawDesc = "" +
 	"\n" +
-	"\fdriver.proto\x12\x06driver\"U\n" +
+	"\fdriver.proto\x12\x06driver\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x01\n" +
 	"\x15RegisterDriverRequest\x12\x1a\n" +
-	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x12 \n" +
-	"\vpackageSlug\x18\x02 \x01(\tR\vpackageSlug\"@\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x12$\n" +
+	"\vpackageSlug\x18\x02 \x01(\tB\x02\x18\x01R\vpackageSlug\x12;\n" +
+	"\x0evehiclePackage\x18\x03 \x01(\x0e2\x13.driver.PackageSlugR\x0evehiclePackage\"@\n" +
 	"\x16RegisterDriverResponse\x12&\n" +
-	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\xda\x01\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"5\n" +
+	"\x17UnregisterDriverRequest\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\"B\n" +
+	"\x18UnregisterDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\x8c\x01\n" +
+	"\x16SetAvailabilityRequest\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x12>\n" +
+	"\favailability\x18\x02 \x01(\x0e2\x1a.driver.DriverAvailabilityR\favailability\x12\x16\n" +
+	"\x06tripID\x18\x03 \x01(\tR\x06tripID\"A\n" +
+	"\x17SetAvailabilityResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\x9a\x01\n" +
+	"\x14UpdateVehicleRequest\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x12)\n" +
+	"\avehicle\x18\x02 \x01(\v2\x0f.driver.VehicleR\avehicle\x12;\n" +
+	"\x0evehiclePackage\x18\x03 \x01(\x0e2\x13.driver.PackageSlugR\x0evehiclePackage\"?\n" +
+	"\x15UpdateVehicleResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\xcb\x01\n" +
+	"\x14UpdateProfileRequest\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12+\n" +
+	"\x0eprofilePicture\x18\x03 \x01(\tH\x01R\x0eprofilePicture\x88\x01\x01\x12%\n" +
+	"\vphoneNumber\x18\x04 \x01(\tH\x02R\vphoneNumber\x88\x01\x01B\a\n" +
+	"\x05_nameB\x11\n" +
+	"\x0f_profilePictureB\x0e\n" +
+	"\f_phoneNumber\"?\n" +
+	"\x15UpdateProfileResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\".\n" +
+	"\x10GetDriverRequest\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\";\n" +
+	"\x11GetDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\xef\x01\n" +
+	"\x18ListNearbyDriversRequest\x12,\n" +
+	"\blocation\x18\x01 \x01(\v2\x10.driver.LocationR\blocation\x12&\n" +
+	"\x0eradiusInMeters\x18\x02 \x01(\x01R\x0eradiusInMeters\x127\n" +
+	"\fpackageSlugs\x18\x03 \x03(\x0e2\x13.driver.PackageSlugR\fpackageSlugs\x12.\n" +
+	"\x12includeUnavailable\x18\x04 \x01(\bR\x12includeUnavailable\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"K\n" +
+	"\x19ListNearbyDriversResponse\x12.\n" +
+	"\adrivers\x18\x01 \x03(\v2\x14.driver.NearbyDriverR\adrivers\"b\n" +
+	"\fNearbyDriver\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\x12*\n" +
+	"\x10distanceInMeters\x18\x02 \x01(\x01R\x10distanceInMeters\"\xaa\x02\n" +
+	"\x0eLocationUpdate\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12,\n" +
+	"\blocation\x18\x02 \x01(\v2\x10.driver.LocationR\blocation\x12\x18\n" +
+	"\aheading\x18\x03 \x01(\x01R\aheading\x126\n" +
+	"\x16speedInMetersPerSecond\x18\x04 \x01(\x01R\x16speedInMetersPerSecond\x12*\n" +
+	"\x10accuracyInMeters\x18\x05 \x01(\x01R\x10accuracyInMeters\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06tripID\x18\a \x01(\tR\x06tripID\"]\n" +
+	"\rLocationBatch\x12\x1a\n" +
+	"\bdriverID\x18\x01 \x01(\tR\bdriverID\x120\n" +
+	"\aupdates\x18\x02 \x03(\v2\x16.driver.LocationUpdateR\aupdates\"\x92\x01\n" +
+	"\vLocationAck\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12C\n" +
+	"\x0fminSendInterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fminSendInterval\x12\"\n" +
+	"\fmaxBatchSize\x18\x03 \x01(\x05R\fmaxBatchSize\"\x95\x01\n" +
+	"\aVehicle\x12\x12\n" +
+	"\x04make\x18\x01 \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1a\n" +
+	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate\x12\x12\n" +
+	"\x04year\x18\x05 \x01(\x05R\x04year\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\"\xe2\x03\n" +
 	"\x06Driver\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
 	"\x0eprofilePicture\x18\x03 \x01(\tR\x0eprofilePicture\x12\x1a\n" +
 	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate\x12\x18\n" +
-	"\ageohash\x18\x05 \x01(\tR\ageohash\x12 \n" +
-	"\vpackageSlug\x18\x06 \x01(\tR\vpackageSlug\x12,\n" +
-	"\blocation\x18\a \x01(\v2\x10.driver.LocationR\blocation\"D\n" +
+	"\ageohash\x18\x05 \x01(\tR\ageohash\x12$\n" +
+	"\vpackageSlug\x18\x06 \x01(\tB\x02\x18\x01R\vpackageSlug\x12,\n" +
+	"\blocation\x18\a \x01(\v2\x10.driver.LocationR\blocation\x12>\n" +
+	"\favailability\x18\b \x01(\x0e2\x1a.driver.DriverAvailabilityR\favailability\x12)\n" +
+	"\avehicle\x18\t \x01(\v2\x0f.driver.VehicleR\avehicle\x12 \n" +
+	"\vphoneNumber\x18\n" +
+	" \x01(\tR\vphoneNumber\x12\x16\n" +
+	"\x06rating\x18\v \x01(\x01R\x06rating\x12 \n" +
+	"\vratingCount\x18\f \x01(\x05R\vratingCount\x12;\n" +
+	"\x0evehiclePackage\x18\r \x01(\x0e2\x13.driver.PackageSlugR\x0evehiclePackage\"D\n" +
 	"\bLocation\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x01R\tlongitude2\xb3\x01\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude*\x9b\x01\n" +
+	"\x12DriverAvailability\x12#\n" +
+	"\x1fDRIVER_AVAILABILITY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bDRIVER_AVAILABILITY_OFFLINE\x10\x01\x12\x1e\n" +
+	"\x1aDRIVER_AVAILABILITY_ONLINE\x10\x02\x12\x1f\n" +
+	"\x1bDRIVER_AVAILABILITY_ON_TRIP\x10\x03*r\n" +
+	"\vPackageSlug\x12\x1c\n" +
+	"\x18PACKAGE_SLUG_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PACKAGE_SLUG_SEDAN\x10\x01\x12\x17\n" +
+	"\x13PACKAGE_SLUG_LUXURY\x10\x02\x12\x14\n" +
+	"\x10PACKAGE_SLUG_VAN\x10\x032\x86\x05\n" +
 	"\rDriverService\x12O\n" +
-	"\x0eRegisterDriver\x12\x1d.driver.RegisterDriverRequest\x1a\x1e.driver.RegisterDriverResponse\x12Q\n" +
-	"\x10UnregisterDriver\x12\x1d.driver.RegisterDriverRequest\x1a\x1e.driver.RegisterDriverResponseB,Z*github.com/ride4Low/contracts/proto/driverb\x06proto3"
+	"\x0eRegisterDriver\x12\x1d.driver.RegisterDriverRequest\x1a\x1e.driver.RegisterDriverResponse\x12U\n" +
+	"\x10UnregisterDriver\x12\x1f.driver.UnregisterDriverRequest\x1a .driver.UnregisterDriverResponse\x12R\n" +
+	"\x0fSetAvailability\x12\x1e.driver.SetAvailabilityRequest\x1a\x1f.driver.SetAvailabilityResponse\x12L\n" +
+	"\rUpdateVehicle\x12\x1c.driver.UpdateVehicleRequest\x1a\x1d.driver.UpdateVehicleResponse\x12L\n" +
+	"\rUpdateProfile\x12\x1c.driver.UpdateProfileRequest\x1a\x1d.driver.UpdateProfileResponse\x12@\n" +
+	"\tGetDriver\x12\x18.driver.GetDriverRequest\x1a\x19.driver.GetDriverResponse\x12X\n" +
+	"\x11ListNearbyDrivers\x12 .driver.ListNearbyDriversRequest\x1a!.driver.ListNearbyDriversResponse\x12A\n" +
+	"\x0fStreamLocations\x12\x15.driver.LocationBatch\x1a\x13.driver.LocationAck(\x010\x01B,Z*github.com/ride4Low/contracts/proto/driverb\x06proto3"
 
 var (
 	file_driver_proto_rawDescOnce sync.Once
@@ -298,25 +1520,79 @@ func file_driver_proto_rawDescGZIP() []byte {
 	return file_driver_proto_rawDescData
 }
 
-var file_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_driver_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_driver_proto_goTypes = []any{
-	(*RegisterDriverRequest)(nil),  // 0: driver.RegisterDriverRequest
-	(*RegisterDriverResponse)(nil), // 1: driver.RegisterDriverResponse
-	(*Driver)(nil),                 // 2: driver.Driver
-	(*Location)(nil),               // 3: driver.Location
+	(DriverAvailability)(0),           // 0: driver.DriverAvailability
+	(PackageSlug)(0),                  // 1: driver.PackageSlug
+	(*RegisterDriverRequest)(nil),     // 2: driver.RegisterDriverRequest
+	(*RegisterDriverResponse)(nil),    // 3: driver.RegisterDriverResponse
+	(*UnregisterDriverRequest)(nil),   // 4: driver.UnregisterDriverRequest
+	(*UnregisterDriverResponse)(nil),  // 5: driver.UnregisterDriverResponse
+	(*SetAvailabilityRequest)(nil),    // 6: driver.SetAvailabilityRequest
+	(*SetAvailabilityResponse)(nil),   // 7: driver.SetAvailabilityResponse
+	(*UpdateVehicleRequest)(nil),      // 8: driver.UpdateVehicleRequest
+	(*UpdateVehicleResponse)(nil),     // 9: driver.UpdateVehicleResponse
+	(*UpdateProfileRequest)(nil),      // 10: driver.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),     // 11: driver.UpdateProfileResponse
+	(*GetDriverRequest)(nil),          // 12: driver.GetDriverRequest
+	(*GetDriverResponse)(nil),         // 13: driver.GetDriverResponse
+	(*ListNearbyDriversRequest)(nil),  // 14: driver.ListNearbyDriversRequest
+	(*ListNearbyDriversResponse)(nil), // 15: driver.ListNearbyDriversResponse
+	(*NearbyDriver)(nil),              // 16: driver.NearbyDriver
+	(*LocationUpdate)(nil),            // 17: driver.LocationUpdate
+	(*LocationBatch)(nil),             // 18: driver.LocationBatch
+	(*LocationAck)(nil),               // 19: driver.LocationAck
+	(*Vehicle)(nil),                   // 20: driver.Vehicle
+	(*Driver)(nil),                    // 21: driver.Driver
+	(*Location)(nil),                  // 22: driver.Location
+	(*timestamppb.Timestamp)(nil),     // 23: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 24: google.protobuf.Duration
 }
 var file_driver_proto_depIdxs = []int32{
-	2, // 0: driver.RegisterDriverResponse.driver:type_name -> driver.Driver
-	3, // 1: driver.Driver.location:type_name -> driver.Location
-	0, // 2: driver.DriverService.RegisterDriver:input_type -> driver.RegisterDriverRequest
-	0, // 3: driver.DriverService.UnregisterDriver:input_type -> driver.RegisterDriverRequest
-	1, // 4: driver.DriverService.RegisterDriver:output_type -> driver.RegisterDriverResponse
-	1, // 5: driver.DriverService.UnregisterDriver:output_type -> driver.RegisterDriverResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: driver.RegisterDriverRequest.vehiclePackage:type_name -> driver.PackageSlug
+	21, // 1: driver.RegisterDriverResponse.driver:type_name -> driver.Driver
+	21, // 2: driver.UnregisterDriverResponse.driver:type_name -> driver.Driver
+	0,  // 3: driver.SetAvailabilityRequest.availability:type_name -> driver.DriverAvailability
+	21, // 4: driver.SetAvailabilityResponse.driver:type_name -> driver.Driver
+	20, // 5: driver.UpdateVehicleRequest.vehicle:type_name -> driver.Vehicle
+	1,  // 6: driver.UpdateVehicleRequest.vehiclePackage:type_name -> driver.PackageSlug
+	21, // 7: driver.UpdateVehicleResponse.driver:type_name -> driver.Driver
+	21, // 8: driver.UpdateProfileResponse.driver:type_name -> driver.Driver
+	21, // 9: driver.GetDriverResponse.driver:type_name -> driver.Driver
+	22, // 10: driver.ListNearbyDriversRequest.location:type_name -> driver.Location
+	1,  // 11: driver.ListNearbyDriversRequest.packageSlugs:type_name -> driver.PackageSlug
+	16, // 12: driver.ListNearbyDriversResponse.drivers:type_name -> driver.NearbyDriver
+	21, // 13: driver.NearbyDriver.driver:type_name -> driver.Driver
+	22, // 14: driver.LocationUpdate.location:type_name -> driver.Location
+	23, // 15: driver.LocationUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	17, // 16: driver.LocationBatch.updates:type_name -> driver.LocationUpdate
+	24, // 17: driver.LocationAck.minSendInterval:type_name -> google.protobuf.Duration
+	22, // 18: driver.Driver.location:type_name -> driver.Location
+	0,  // 19: driver.Driver.availability:type_name -> driver.DriverAvailability
+	20, // 20: driver.Driver.vehicle:type_name -> driver.Vehicle
+	1,  // 21: driver.Driver.vehiclePackage:type_name -> driver.PackageSlug
+	2,  // 22: driver.DriverService.RegisterDriver:input_type -> driver.RegisterDriverRequest
+	4,  // 23: driver.DriverService.UnregisterDriver:input_type -> driver.UnregisterDriverRequest
+	6,  // 24: driver.DriverService.SetAvailability:input_type -> driver.SetAvailabilityRequest
+	8,  // 25: driver.DriverService.UpdateVehicle:input_type -> driver.UpdateVehicleRequest
+	10, // 26: driver.DriverService.UpdateProfile:input_type -> driver.UpdateProfileRequest
+	12, // 27: driver.DriverService.GetDriver:input_type -> driver.GetDriverRequest
+	14, // 28: driver.DriverService.ListNearbyDrivers:input_type -> driver.ListNearbyDriversRequest
+	18, // 29: driver.DriverService.StreamLocations:input_type -> driver.LocationBatch
+	3,  // 30: driver.DriverService.RegisterDriver:output_type -> driver.RegisterDriverResponse
+	5,  // 31: driver.DriverService.UnregisterDriver:output_type -> driver.UnregisterDriverResponse
+	7,  // 32: driver.DriverService.SetAvailability:output_type -> driver.SetAvailabilityResponse
+	9,  // 33: driver.DriverService.UpdateVehicle:output_type -> driver.UpdateVehicleResponse
+	11, // 34: driver.DriverService.UpdateProfile:output_type -> driver.UpdateProfileResponse
+	13, // 35: driver.DriverService.GetDriver:output_type -> driver.GetDriverResponse
+	15, // 36: driver.DriverService.ListNearbyDrivers:output_type -> driver.ListNearbyDriversResponse
+	19, // 37: driver.DriverService.StreamLocations:output_type -> driver.LocationAck
+	30, // [30:38] is the sub-list for method output_type
+	22, // [22:30] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_driver_proto_init() }
@@ -324,18 +1600,20 @@ func file_driver_proto_init() {
 	if File_driver_proto != nil {
 		return
 	}
+	file_driver_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_driver_proto_rawDesc), len(file_driver_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_driver_proto_goTypes,
 		DependencyIndexes: file_driver_proto_depIdxs,
+		EnumInfos:         file_driver_proto_enumTypes,
 		MessageInfos:      file_driver_proto_msgTypes,
 	}.Build()
 	File_driver_proto = out.File
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DriverService_RegisterDriver_FullMethodName   = "/driver.DriverService/RegisterDriver"
-	DriverService_UnregisterDriver_FullMethodName = "/driver.DriverService/UnregisterDriver"
+	DriverService_RegisterDriver_FullMethodName    = "/driver.DriverService/RegisterDriver"
+	DriverService_UnregisterDriver_FullMethodName  = "/driver.DriverService/UnregisterDriver"
+	DriverService_SetAvailability_FullMethodName   = "/driver.DriverService/SetAvailability"
+	DriverService_UpdateVehicle_FullMethodName     = "/driver.DriverService/UpdateVehicle"
+	DriverService_UpdateProfile_FullMethodName     = "/driver.DriverService/UpdateProfile"
+	DriverService_GetDriver_FullMethodName         = "/driver.DriverService/GetDriver"
+	DriverService_ListNearbyDrivers_FullMethodName = "/driver.DriverService/ListNearbyDrivers"
+	DriverService_StreamLocations_FullMethodName   = "/driver.DriverService/StreamLocations"
 )
 
 // DriverServiceClient is the client API for DriverService service.
@@ -28,7 +34,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DriverServiceClient interface {
 	RegisterDriver(ctx context.Context, in *RegisterDriverRequest, opts ...grpc.CallOption) (*RegisterDriverResponse, error)
-	UnregisterDriver(ctx context.Context, in *RegisterDriverRequest, opts ...grpc.CallOption) (*RegisterDriverResponse, error)
+	UnregisterDriver(ctx context.Context, in *UnregisterDriverRequest, opts ...grpc.CallOption) (*UnregisterDriverResponse, error)
+	SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*SetAvailabilityResponse, error)
+	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error)
+	ListNearbyDrivers(ctx context.Context, in *ListNearbyDriversRequest, opts ...grpc.CallOption) (*ListNearbyDriversResponse, error)
+	StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LocationBatch, LocationAck], error)
 }
 
 type driverServiceClient struct {
@@ -49,9 +61,9 @@ func (c *driverServiceClient) RegisterDriver(ctx context.Context, in *RegisterDr
 	return out, nil
 }
 
-func (c *driverServiceClient) UnregisterDriver(ctx context.Context, in *RegisterDriverRequest, opts ...grpc.CallOption) (*RegisterDriverResponse, error) {
+func (c *driverServiceClient) UnregisterDriver(ctx context.Context, in *UnregisterDriverRequest, opts ...grpc.CallOption) (*UnregisterDriverResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RegisterDriverResponse)
+	out := new(UnregisterDriverResponse)
 	err := c.cc.Invoke(ctx, DriverService_UnregisterDriver_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -59,12 +71,81 @@ func (c *driverServiceClient) UnregisterDriver(ctx context.Context, in *Register
 	return out, nil
 }
 
+func (c *driverServiceClient) SetAvailability(ctx context.Context, in *SetAvailabilityRequest, opts ...grpc.CallOption) (*SetAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAvailabilityResponse)
+	err := c.cc.Invoke(ctx, DriverService_SetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVehicleResponse)
+	err := c.cc.Invoke(ctx, DriverService_UpdateVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, DriverService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListNearbyDrivers(ctx context.Context, in *ListNearbyDriversRequest, opts ...grpc.CallOption) (*ListNearbyDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNearbyDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListNearbyDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LocationBatch, LocationAck], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DriverService_ServiceDesc.Streams[0], DriverService_StreamLocations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LocationBatch, LocationAck]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationsClient = grpc.BidiStreamingClient[LocationBatch, LocationAck]
+
 // DriverServiceServer is the server API for DriverService service.
 // All implementations must embed UnimplementedDriverServiceServer
 // for forward compatibility.
 type DriverServiceServer interface {
 	RegisterDriver(context.Context, *RegisterDriverRequest) (*RegisterDriverResponse, error)
-	UnregisterDriver(context.Context, *RegisterDriverRequest) (*RegisterDriverResponse, error)
+	UnregisterDriver(context.Context, *UnregisterDriverRequest) (*UnregisterDriverResponse, error)
+	SetAvailability(context.Context, *SetAvailabilityRequest) (*SetAvailabilityResponse, error)
+	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error)
+	ListNearbyDrivers(context.Context, *ListNearbyDriversRequest) (*ListNearbyDriversResponse, error)
+	StreamLocations(grpc.BidiStreamingServer[LocationBatch, LocationAck]) error
 	mustEmbedUnimplementedDriverServiceServer()
 }
 
@@ -78,9 +159,27 @@ type UnimplementedDriverServiceServer struct{}
 func (UnimplementedDriverServiceServer) RegisterDriver(context.Context, *RegisterDriverRequest) (*RegisterDriverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterDriver not implemented")
 }
-func (UnimplementedDriverServiceServer) UnregisterDriver(context.Context, *RegisterDriverRequest) (*RegisterDriverResponse, error) {
+func (UnimplementedDriverServiceServer) UnregisterDriver(context.Context, *UnregisterDriverRequest) (*UnregisterDriverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDriver not implemented")
 }
+func (UnimplementedDriverServiceServer) SetAvailability(context.Context, *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvailability not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListNearbyDrivers(context.Context, *ListNearbyDriversRequest) (*ListNearbyDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNearbyDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) StreamLocations(grpc.BidiStreamingServer[LocationBatch, LocationAck]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLocations not implemented")
+}
 func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
 func (UnimplementedDriverServiceServer) testEmbeddedByValue()                       {}
 
@@ -121,7 +220,7 @@ func _DriverService_RegisterDriver_Handler(srv interface{}, ctx context.Context,
 }
 
 func _DriverService_UnregisterDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterDriverRequest)
+	in := new(UnregisterDriverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -133,11 +232,108 @@ func _DriverService_UnregisterDriver_Handler(srv interface{}, ctx context.Contex
 		FullMethod: DriverService_UnregisterDriver_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DriverServiceServer).UnregisterDriver(ctx, req.(*RegisterDriverRequest))
+		return srv.(DriverServiceServer).UnregisterDriver(ctx, req.(*UnregisterDriverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DriverService_SetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).SetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_SetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).SetAvailability(ctx, req.(*SetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateVehicle(ctx, req.(*UpdateVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriver(ctx, req.(*GetDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListNearbyDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNearbyDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListNearbyDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListNearbyDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListNearbyDrivers(ctx, req.(*ListNearbyDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_StreamLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DriverServiceServer).StreamLocations(&grpc.GenericServerStream[LocationBatch, LocationAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationsServer = grpc.BidiStreamingServer[LocationBatch, LocationAck]
+
 // DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +349,34 @@ var DriverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterDriver",
 			Handler:    _DriverService_UnregisterDriver_Handler,
 		},
+		{
+			MethodName: "SetAvailability",
+			Handler:    _DriverService_SetAvailability_Handler,
+		},
+		{
+			MethodName: "UpdateVehicle",
+			Handler:    _DriverService_UpdateVehicle_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _DriverService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetDriver",
+			Handler:    _DriverService_GetDriver_Handler,
+		},
+		{
+			MethodName: "ListNearbyDrivers",
+			Handler:    _DriverService_ListNearbyDrivers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocations",
+			Handler:       _DriverService_StreamLocations_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "driver.proto",
 }
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: notification.proto
+
+package notification
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Channel int32
+
+const (
+	Channel_CHANNEL_UNSPECIFIED Channel = 0
+	Channel_CHANNEL_PUSH        Channel = 1
+	Channel_CHANNEL_SMS         Channel = 2
+	Channel_CHANNEL_EMAIL       Channel = 3
+	Channel_CHANNEL_WEBSOCKET   Channel = 4
+)
+
+// Enum value maps for Channel.
+var (
+	Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "CHANNEL_PUSH",
+		2: "CHANNEL_SMS",
+		3: "CHANNEL_EMAIL",
+		4: "CHANNEL_WEBSOCKET",
+	}
+	Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"CHANNEL_PUSH":        1,
+		"CHANNEL_SMS":         2,
+		"CHANNEL_EMAIL":       3,
+		"CHANNEL_WEBSOCKET":   4,
+	}
+)
+
+func (x Channel) Enum() *Channel {
+	p := new(Channel)
+	*p = x
+	return p
+}
+
+func (x Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (Channel) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[0]
+}
+
+func (x Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Channel.Descriptor instead.
+func (Channel) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0
+	Priority_PRIORITY_LOW         Priority = 1
+	Priority_PRIORITY_NORMAL      Priority = 2
+	Priority_PRIORITY_HIGH        Priority = 3
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_NORMAL",
+		3: "PRIORITY_HIGH",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_NORMAL":      2,
+		"PRIORITY_HIGH":        3,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[1]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+type RecipientType int32
+
+const (
+	RecipientType_RECIPIENT_TYPE_UNSPECIFIED RecipientType = 0
+	RecipientType_RECIPIENT_TYPE_RIDER       RecipientType = 1
+	RecipientType_RECIPIENT_TYPE_DRIVER      RecipientType = 2
+)
+
+// Enum value maps for RecipientType.
+var (
+	RecipientType_name = map[int32]string{
+		0: "RECIPIENT_TYPE_UNSPECIFIED",
+		1: "RECIPIENT_TYPE_RIDER",
+		2: "RECIPIENT_TYPE_DRIVER",
+	}
+	RecipientType_value = map[string]int32{
+		"RECIPIENT_TYPE_UNSPECIFIED": 0,
+		"RECIPIENT_TYPE_RIDER":       1,
+		"RECIPIENT_TYPE_DRIVER":      2,
+	}
+)
+
+func (x RecipientType) Enum() *RecipientType {
+	p := new(RecipientType)
+	*p = x
+	return p
+}
+
+func (x RecipientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (RecipientType) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[2]
+}
+
+func (x RecipientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipientType.Descriptor instead.
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	DeliveryStatus_DELIVERY_STATUS_SENT        DeliveryStatus = 1
+	DeliveryStatus_DELIVERY_STATUS_DELIVERED   DeliveryStatus = 2
+	DeliveryStatus_DELIVERY_STATUS_FAILED      DeliveryStatus = 3
+	// Dropped because a notification with the same dedupKey was already sent.
+	DeliveryStatus_DELIVERY_STATUS_DEDUPLICATED DeliveryStatus = 4
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "DELIVERY_STATUS_SENT",
+		2: "DELIVERY_STATUS_DELIVERED",
+		3: "DELIVERY_STATUS_FAILED",
+		4: "DELIVERY_STATUS_DEDUPLICATED",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED":  0,
+		"DELIVERY_STATUS_SENT":         1,
+		"DELIVERY_STATUS_DELIVERED":    2,
+		"DELIVERY_STATUS_FAILED":       3,
+		"DELIVERY_STATUS_DEDUPLICATED": 4,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[3].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[3]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{3}
+}
+
+type Recipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          RecipientType          `protobuf:"varint,1,opt,name=type,proto3,enum=notification.RecipientType" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipient) Reset() {
+	*x = Recipient{}
+	mi := &file_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipient) ProtoMessage() {}
+
+func (x *Recipient) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipient.ProtoReflect.Descriptor instead.
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recipient) GetType() RecipientType {
+	if x != nil {
+		return x.Type
+	}
+	return RecipientType_RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (x *Recipient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Payload of notification.cmd.send.
+type Notification struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient *Recipient             `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Channels the notification is delivered on.
+	Channels []Channel `protobuf:"varint,3,rep,packed,name=channels,proto3,enum=notification.Channel" json:"channels,omitempty"`
+	// ex: trip.driver_assigned, payment.success
+	TemplateID string `protobuf:"bytes,4,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	// BCP 47 language tag used to render the template, e.g. "en-US". Defaults to the recipient's locale.
+	Locale   string            `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Params   map[string]string `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Priority Priority          `protobuf:"varint,7,opt,name=priority,proto3,enum=notification.Priority" json:"priority,omitempty"`
+	// Notifications with the same dedupKey for the same recipient are only delivered once.
+	DedupKey      string                 `protobuf:"bytes,8,opt,name=dedupKey,proto3" json:"dedupKey,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetRecipient() *Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *Notification) GetChannels() []Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Notification) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *Notification) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Notification) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Notification) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *Notification) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Payload of notification.event.delivered and notification.event.failed.
+type DeliveryStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationID string                 `protobuf:"bytes,1,opt,name=notificationID,proto3" json:"notificationID,omitempty"`
+	Recipient      *Recipient             `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Channel        Channel                `protobuf:"varint,3,opt,name=channel,proto3,enum=notification.Channel" json:"channel,omitempty"`
+	Status         DeliveryStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=notification.DeliveryStatus" json:"status,omitempty"`
+	FailureReason  string                 `protobuf:"bytes,5,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+	DedupKey       string                 `protobuf:"bytes,6,opt,name=dedupKey,proto3" json:"dedupKey,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeliveryStatusEvent) Reset() {
+	*x = DeliveryStatusEvent{}
+	mi := &file_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryStatusEvent) ProtoMessage() {}
+
+func (x *DeliveryStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryStatusEvent.ProtoReflect.Descriptor instead.
+func (*DeliveryStatusEvent) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliveryStatusEvent) GetNotificationID() string {
+	if x != nil {
+		return x.NotificationID
+	}
+	return ""
+}
+
+func (x *DeliveryStatusEvent) GetRecipient() *Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *DeliveryStatusEvent) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *DeliveryStatusEvent) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *DeliveryStatusEvent) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *DeliveryStatusEvent) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
+func (x *DeliveryStatusEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_notification_proto protoreflect.FileDescriptor
+
+const file_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x12notification.proto\x12\fnotification\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
+	"\tRecipient\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.notification.RecipientTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc5\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\trecipient\x18\x02 \x01(\v2\x17.notification.RecipientR\trecipient\x121\n" +
+	"\bchannels\x18\x03 \x03(\x0e2\x15.notification.ChannelR\bchannels\x12\x1e\n" +
+	"\n" +
+	"templateID\x18\x04 \x01(\tR\n" +
+	"templateID\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x12>\n" +
+	"\x06params\x18\x06 \x03(\v2&.notification.Notification.ParamsEntryR\x06params\x122\n" +
+	"\bpriority\x18\a \x01(\x0e2\x16.notification.PriorityR\bpriority\x12\x1a\n" +
+	"\bdedupKey\x18\b \x01(\tR\bdedupKey\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd7\x02\n" +
+	"\x13DeliveryStatusEvent\x12&\n" +
+	"\x0enotificationID\x18\x01 \x01(\tR\x0enotificationID\x125\n" +
+	"\trecipient\x18\x02 \x01(\v2\x17.notification.RecipientR\trecipient\x12/\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x15.notification.ChannelR\achannel\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.notification.DeliveryStatusR\x06status\x12$\n" +
+	"\rfailureReason\x18\x05 \x01(\tR\rfailureReason\x12\x1a\n" +
+	"\bdedupKey\x18\x06 \x01(\tR\bdedupKey\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*o\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fCHANNEL_PUSH\x10\x01\x12\x0f\n" +
+	"\vCHANNEL_SMS\x10\x02\x12\x11\n" +
+	"\rCHANNEL_EMAIL\x10\x03\x12\x15\n" +
+	"\x11CHANNEL_WEBSOCKET\x10\x04*^\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
+	"\x0fPRIORITY_NORMAL\x10\x02\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x03*d\n" +
+	"\rRecipientType\x12\x1e\n" +
+	"\x1aRECIPIENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14RECIPIENT_TYPE_RIDER\x10\x01\x12\x19\n" +
+	"\x15RECIPIENT_TYPE_DRIVER\x10\x02*\xa8\x01\n" +
+	"\x0eDeliveryStatus\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DELIVERY_STATUS_SENT\x10\x01\x12\x1d\n" +
+	"\x19DELIVERY_STATUS_DELIVERED\x10\x02\x12\x1a\n" +
+	"\x16DELIVERY_STATUS_FAILED\x10\x03\x12 \n" +
+	"\x1cDELIVERY_STATUS_DEDUPLICATED\x10\x04B2Z0github.com/ride4Low/contracts/proto/notificationb\x06proto3"
+
+var (
+	file_notification_proto_rawDescOnce sync.Once
+	file_notification_proto_rawDescData []byte
+)
+
+func file_notification_proto_rawDescGZIP() []byte {
+	file_notification_proto_rawDescOnce.Do(func() {
+		file_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)))
+	})
+	return file_notification_proto_rawDescData
+}
+
+var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_notification_proto_goTypes = []any{
+	(Channel)(0),                  // 0: notification.Channel
+	(Priority)(0),                 // 1: notification.Priority
+	(RecipientType)(0),            // 2: notification.RecipientType
+	(DeliveryStatus)(0),           // 3: notification.DeliveryStatus
+	(*Recipient)(nil),             // 4: notification.Recipient
+	(*Notification)(nil),          // 5: notification.Notification
+	(*DeliveryStatusEvent)(nil),   // 6: notification.DeliveryStatusEvent
+	nil,                           // 7: notification.Notification.ParamsEntry
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_notification_proto_depIdxs = []int32{
+	2,  // 0: notification.Recipient.type:type_name -> notification.RecipientType
+	4,  // 1: notification.Notification.recipient:type_name -> notification.Recipient
+	0,  // 2: notification.Notification.channels:type_name -> notification.Channel
+	7,  // 3: notification.Notification.params:type_name -> notification.Notification.ParamsEntry
+	1,  // 4: notification.Notification.priority:type_name -> notification.Priority
+	8,  // 5: notification.Notification.createdAt:type_name -> google.protobuf.Timestamp
+	4,  // 6: notification.DeliveryStatusEvent.recipient:type_name -> notification.Recipient
+	0,  // 7: notification.DeliveryStatusEvent.channel:type_name -> notification.Channel
+	3,  // 8: notification.DeliveryStatusEvent.status:type_name -> notification.DeliveryStatus
+	8,  // 9: notification.DeliveryStatusEvent.timestamp:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_notification_proto_init() }
+func file_notification_proto_init() {
+	if File_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_notification_proto_goTypes,
+		DependencyIndexes: file_notification_proto_depIdxs,
+		EnumInfos:         file_notification_proto_enumTypes,
+		MessageInfos:      file_notification_proto_msgTypes,
+	}.Build()
+	File_notification_proto = out.File
+	file_notification_proto_goTypes = nil
+	file_notification_proto_depIdxs = nil
+}
@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: rating.proto
+
+package rating
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubjectType int32
+
+const (
+	SubjectType_SUBJECT_TYPE_UNSPECIFIED SubjectType = 0
+	SubjectType_SUBJECT_TYPE_DRIVER      SubjectType = 1
+	SubjectType_SUBJECT_TYPE_RIDER       SubjectType = 2
+)
+
+// Enum value maps for SubjectType.
+var (
+	SubjectType_name = map[int32]string{
+		0: "SUBJECT_TYPE_UNSPECIFIED",
+		1: "SUBJECT_TYPE_DRIVER",
+		2: "SUBJECT_TYPE_RIDER",
+	}
+	SubjectType_value = map[string]int32{
+		"SUBJECT_TYPE_UNSPECIFIED": 0,
+		"SUBJECT_TYPE_DRIVER":      1,
+		"SUBJECT_TYPE_RIDER":       2,
+	}
+)
+
+func (x SubjectType) Enum() *SubjectType {
+	p := new(SubjectType)
+	*p = x
+	return p
+}
+
+func (x SubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rating_proto_enumTypes[0].Descriptor()
+}
+
+func (SubjectType) Type() protoreflect.EnumType {
+	return &file_rating_proto_enumTypes[0]
+}
+
+func (x SubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubjectType.Descriptor instead.
+func (SubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{0}
+}
+
+type Rating struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripID      string                 `protobuf:"bytes,2,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	RaterID     string                 `protobuf:"bytes,3,opt,name=raterID,proto3" json:"raterID,omitempty"`
+	SubjectType SubjectType            `protobuf:"varint,4,opt,name=subjectType,proto3,enum=rating.SubjectType" json:"subjectType,omitempty"`
+	SubjectID   string                 `protobuf:"bytes,5,opt,name=subjectID,proto3" json:"subjectID,omitempty"`
+	// From 1 to 5.
+	Stars int32 `protobuf:"varint,6,opt,name=stars,proto3" json:"stars,omitempty"`
+	// ex: clean_car, friendly, on_time
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_rating_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rating) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rating) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *Rating) GetRaterID() string {
+	if x != nil {
+		return x.RaterID
+	}
+	return ""
+}
+
+func (x *Rating) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *Rating) GetSubjectID() string {
+	if x != nil {
+		return x.SubjectID
+	}
+	return ""
+}
+
+func (x *Rating) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *Rating) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Rating) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AggregateRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   SubjectType            `protobuf:"varint,1,opt,name=subjectType,proto3,enum=rating.SubjectType" json:"subjectType,omitempty"`
+	SubjectID     string                 `protobuf:"bytes,2,opt,name=subjectID,proto3" json:"subjectID,omitempty"`
+	Average       float64                `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRating) Reset() {
+	*x = AggregateRating{}
+	mi := &file_rating_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRating) ProtoMessage() {}
+
+func (x *AggregateRating) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRating.ProtoReflect.Descriptor instead.
+func (*AggregateRating) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AggregateRating) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *AggregateRating) GetSubjectID() string {
+	if x != nil {
+		return x.SubjectID
+	}
+	return ""
+}
+
+func (x *AggregateRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *AggregateRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SubmitRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	RaterID       string                 `protobuf:"bytes,2,opt,name=raterID,proto3" json:"raterID,omitempty"`
+	SubjectType   SubjectType            `protobuf:"varint,3,opt,name=subjectType,proto3,enum=rating.SubjectType" json:"subjectType,omitempty"`
+	SubjectID     string                 `protobuf:"bytes,4,opt,name=subjectID,proto3" json:"subjectID,omitempty"`
+	Stars         int32                  `protobuf:"varint,5,opt,name=stars,proto3" json:"stars,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingRequest) Reset() {
+	*x = SubmitRatingRequest{}
+	mi := &file_rating_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingRequest) ProtoMessage() {}
+
+func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRatingRequest) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitRatingRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetRaterID() string {
+	if x != nil {
+		return x.RaterID
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *SubmitRatingRequest) GetSubjectID() string {
+	if x != nil {
+		return x.SubjectID
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SubmitRatingRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SubmitRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *Rating                `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Aggregate     *AggregateRating       `protobuf:"bytes,2,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingResponse) Reset() {
+	*x = SubmitRatingResponse{}
+	mi := &file_rating_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingResponse) ProtoMessage() {}
+
+func (x *SubmitRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingResponse.ProtoReflect.Descriptor instead.
+func (*SubmitRatingResponse) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitRatingResponse) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *SubmitRatingResponse) GetAggregate() *AggregateRating {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+type GetAggregateRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   SubjectType            `protobuf:"varint,1,opt,name=subjectType,proto3,enum=rating.SubjectType" json:"subjectType,omitempty"`
+	SubjectID     string                 `protobuf:"bytes,2,opt,name=subjectID,proto3" json:"subjectID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAggregateRatingRequest) Reset() {
+	*x = GetAggregateRatingRequest{}
+	mi := &file_rating_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregateRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregateRatingRequest) ProtoMessage() {}
+
+func (x *GetAggregateRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregateRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregateRatingRequest) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAggregateRatingRequest) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *GetAggregateRatingRequest) GetSubjectID() string {
+	if x != nil {
+		return x.SubjectID
+	}
+	return ""
+}
+
+type GetAggregateRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aggregate     *AggregateRating       `protobuf:"bytes,1,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAggregateRatingResponse) Reset() {
+	*x = GetAggregateRatingResponse{}
+	mi := &file_rating_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregateRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregateRatingResponse) ProtoMessage() {}
+
+func (x *GetAggregateRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregateRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetAggregateRatingResponse) Descriptor() ([]byte, []int) {
+	return file_rating_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAggregateRatingResponse) GetAggregate() *AggregateRating {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+var File_rating_proto protoreflect.FileDescriptor
+
+const file_rating_proto_rawDesc = "" +
+	"\n" +
+	"\frating.proto\x12\x06rating\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x02\n" +
+	"\x06Rating\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06tripID\x18\x02 \x01(\tR\x06tripID\x12\x18\n" +
+	"\araterID\x18\x03 \x01(\tR\araterID\x125\n" +
+	"\vsubjectType\x18\x04 \x01(\x0e2\x13.rating.SubjectTypeR\vsubjectType\x12\x1c\n" +
+	"\tsubjectID\x18\x05 \x01(\tR\tsubjectID\x12\x14\n" +
+	"\x05stars\x18\x06 \x01(\x05R\x05stars\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x01\n" +
+	"\x0fAggregateRating\x125\n" +
+	"\vsubjectType\x18\x01 \x01(\x0e2\x13.rating.SubjectTypeR\vsubjectType\x12\x1c\n" +
+	"\tsubjectID\x18\x02 \x01(\tR\tsubjectID\x12\x18\n" +
+	"\aaverage\x18\x03 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\xe0\x01\n" +
+	"\x13SubmitRatingRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x18\n" +
+	"\araterID\x18\x02 \x01(\tR\araterID\x125\n" +
+	"\vsubjectType\x18\x03 \x01(\x0e2\x13.rating.SubjectTypeR\vsubjectType\x12\x1c\n" +
+	"\tsubjectID\x18\x04 \x01(\tR\tsubjectID\x12\x14\n" +
+	"\x05stars\x18\x05 \x01(\x05R\x05stars\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\"u\n" +
+	"\x14SubmitRatingResponse\x12&\n" +
+	"\x06rating\x18\x01 \x01(\v2\x0e.rating.RatingR\x06rating\x125\n" +
+	"\taggregate\x18\x02 \x01(\v2\x17.rating.AggregateRatingR\taggregate\"p\n" +
+	"\x19GetAggregateRatingRequest\x125\n" +
+	"\vsubjectType\x18\x01 \x01(\x0e2\x13.rating.SubjectTypeR\vsubjectType\x12\x1c\n" +
+	"\tsubjectID\x18\x02 \x01(\tR\tsubjectID\"S\n" +
+	"\x1aGetAggregateRatingResponse\x125\n" +
+	"\taggregate\x18\x01 \x01(\v2\x17.rating.AggregateRatingR\taggregate*\\\n" +
+	"\vSubjectType\x12\x1c\n" +
+	"\x18SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SUBJECT_TYPE_DRIVER\x10\x01\x12\x16\n" +
+	"\x12SUBJECT_TYPE_RIDER\x10\x022\xb7\x01\n" +
+	"\rRatingService\x12I\n" +
+	"\fSubmitRating\x12\x1b.rating.SubmitRatingRequest\x1a\x1c.rating.SubmitRatingResponse\x12[\n" +
+	"\x12GetAggregateRating\x12!.rating.GetAggregateRatingRequest\x1a\".rating.GetAggregateRatingResponseB,Z*github.com/ride4Low/contracts/proto/ratingb\x06proto3"
+
+var (
+	file_rating_proto_rawDescOnce sync.Once
+	file_rating_proto_rawDescData []byte
+)
+
+func file_rating_proto_rawDescGZIP() []byte {
+	file_rating_proto_rawDescOnce.Do(func() {
+		file_rating_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rating_proto_rawDesc), len(file_rating_proto_rawDesc)))
+	})
+	return file_rating_proto_rawDescData
+}
+
+var file_rating_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rating_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_rating_proto_goTypes = []any{
+	(SubjectType)(0),                   // 0: rating.SubjectType
+	(*Rating)(nil),                     // 1: rating.Rating
+	(*AggregateRating)(nil),            // 2: rating.AggregateRating
+	(*SubmitRatingRequest)(nil),        // 3: rating.SubmitRatingRequest
+	(*SubmitRatingResponse)(nil),       // 4: rating.SubmitRatingResponse
+	(*GetAggregateRatingRequest)(nil),  // 5: rating.GetAggregateRatingRequest
+	(*GetAggregateRatingResponse)(nil), // 6: rating.GetAggregateRatingResponse
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+}
+var file_rating_proto_depIdxs = []int32{
+	0,  // 0: rating.Rating.subjectType:type_name -> rating.SubjectType
+	7,  // 1: rating.Rating.createdAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: rating.AggregateRating.subjectType:type_name -> rating.SubjectType
+	0,  // 3: rating.SubmitRatingRequest.subjectType:type_name -> rating.SubjectType
+	1,  // 4: rating.SubmitRatingResponse.rating:type_name -> rating.Rating
+	2,  // 5: rating.SubmitRatingResponse.aggregate:type_name -> rating.AggregateRating
+	0,  // 6: rating.GetAggregateRatingRequest.subjectType:type_name -> rating.SubjectType
+	2,  // 7: rating.GetAggregateRatingResponse.aggregate:type_name -> rating.AggregateRating
+	3,  // 8: rating.RatingService.SubmitRating:input_type -> rating.SubmitRatingRequest
+	5,  // 9: rating.RatingService.GetAggregateRating:input_type -> rating.GetAggregateRatingRequest
+	4,  // 10: rating.RatingService.SubmitRating:output_type -> rating.SubmitRatingResponse
+	6,  // 11: rating.RatingService.GetAggregateRating:output_type -> rating.GetAggregateRatingResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_rating_proto_init() }
+func file_rating_proto_init() {
+	if File_rating_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rating_proto_rawDesc), len(file_rating_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rating_proto_goTypes,
+		DependencyIndexes: file_rating_proto_depIdxs,
+		EnumInfos:         file_rating_proto_enumTypes,
+		MessageInfos:      file_rating_proto_msgTypes,
+	}.Build()
+	File_rating_proto = out.File
+	file_rating_proto_goTypes = nil
+	file_rating_proto_depIdxs = nil
+}
@@ -9,6 +9,8 @@ package trip
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,13 +23,183 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PackageSlug int32
+
+const (
+	PackageSlug_PACKAGE_SLUG_UNSPECIFIED PackageSlug = 0
+	PackageSlug_PACKAGE_SLUG_SEDAN       PackageSlug = 1
+	PackageSlug_PACKAGE_SLUG_LUXURY      PackageSlug = 2
+	PackageSlug_PACKAGE_SLUG_VAN         PackageSlug = 3
+)
+
+// Enum value maps for PackageSlug.
+var (
+	PackageSlug_name = map[int32]string{
+		0: "PACKAGE_SLUG_UNSPECIFIED",
+		1: "PACKAGE_SLUG_SEDAN",
+		2: "PACKAGE_SLUG_LUXURY",
+		3: "PACKAGE_SLUG_VAN",
+	}
+	PackageSlug_value = map[string]int32{
+		"PACKAGE_SLUG_UNSPECIFIED": 0,
+		"PACKAGE_SLUG_SEDAN":       1,
+		"PACKAGE_SLUG_LUXURY":      2,
+		"PACKAGE_SLUG_VAN":         3,
+	}
+)
+
+func (x PackageSlug) Enum() *PackageSlug {
+	p := new(PackageSlug)
+	*p = x
+	return p
+}
+
+func (x PackageSlug) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageSlug) Descriptor() protoreflect.EnumDescriptor {
+	return file_trip_proto_enumTypes[0].Descriptor()
+}
+
+func (PackageSlug) Type() protoreflect.EnumType {
+	return &file_trip_proto_enumTypes[0]
+}
+
+func (x PackageSlug) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageSlug.Descriptor instead.
+func (PackageSlug) EnumDescriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{0}
+}
+
+type CancelledBy int32
+
+const (
+	CancelledBy_CANCELLED_BY_UNSPECIFIED CancelledBy = 0
+	CancelledBy_CANCELLED_BY_RIDER       CancelledBy = 1
+	CancelledBy_CANCELLED_BY_DRIVER      CancelledBy = 2
+	CancelledBy_CANCELLED_BY_SYSTEM      CancelledBy = 3
+)
+
+// Enum value maps for CancelledBy.
+var (
+	CancelledBy_name = map[int32]string{
+		0: "CANCELLED_BY_UNSPECIFIED",
+		1: "CANCELLED_BY_RIDER",
+		2: "CANCELLED_BY_DRIVER",
+		3: "CANCELLED_BY_SYSTEM",
+	}
+	CancelledBy_value = map[string]int32{
+		"CANCELLED_BY_UNSPECIFIED": 0,
+		"CANCELLED_BY_RIDER":       1,
+		"CANCELLED_BY_DRIVER":      2,
+		"CANCELLED_BY_SYSTEM":      3,
+	}
+)
+
+func (x CancelledBy) Enum() *CancelledBy {
+	p := new(CancelledBy)
+	*p = x
+	return p
+}
+
+func (x CancelledBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelledBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_trip_proto_enumTypes[1].Descriptor()
+}
+
+func (CancelledBy) Type() protoreflect.EnumType {
+	return &file_trip_proto_enumTypes[1]
+}
+
+func (x CancelledBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelledBy.Descriptor instead.
+func (CancelledBy) EnumDescriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{1}
+}
+
+type CancellationReason int32
+
+const (
+	CancellationReason_CANCELLATION_REASON_UNSPECIFIED      CancellationReason = 0
+	CancellationReason_CANCELLATION_REASON_CHANGED_PLANS    CancellationReason = 1
+	CancellationReason_CANCELLATION_REASON_DRIVER_TOO_FAR   CancellationReason = 2
+	CancellationReason_CANCELLATION_REASON_DRIVER_NO_SHOW   CancellationReason = 3
+	CancellationReason_CANCELLATION_REASON_RIDER_NO_SHOW    CancellationReason = 4
+	CancellationReason_CANCELLATION_REASON_NO_DRIVERS_FOUND CancellationReason = 5
+	CancellationReason_CANCELLATION_REASON_PAYMENT_FAILED   CancellationReason = 6
+	CancellationReason_CANCELLATION_REASON_OTHER            CancellationReason = 7
+)
+
+// Enum value maps for CancellationReason.
+var (
+	CancellationReason_name = map[int32]string{
+		0: "CANCELLATION_REASON_UNSPECIFIED",
+		1: "CANCELLATION_REASON_CHANGED_PLANS",
+		2: "CANCELLATION_REASON_DRIVER_TOO_FAR",
+		3: "CANCELLATION_REASON_DRIVER_NO_SHOW",
+		4: "CANCELLATION_REASON_RIDER_NO_SHOW",
+		5: "CANCELLATION_REASON_NO_DRIVERS_FOUND",
+		6: "CANCELLATION_REASON_PAYMENT_FAILED",
+		7: "CANCELLATION_REASON_OTHER",
+	}
+	CancellationReason_value = map[string]int32{
+		"CANCELLATION_REASON_UNSPECIFIED":      0,
+		"CANCELLATION_REASON_CHANGED_PLANS":    1,
+		"CANCELLATION_REASON_DRIVER_TOO_FAR":   2,
+		"CANCELLATION_REASON_DRIVER_NO_SHOW":   3,
+		"CANCELLATION_REASON_RIDER_NO_SHOW":    4,
+		"CANCELLATION_REASON_NO_DRIVERS_FOUND": 5,
+		"CANCELLATION_REASON_PAYMENT_FAILED":   6,
+		"CANCELLATION_REASON_OTHER":            7,
+	}
+)
+
+func (x CancellationReason) Enum() *CancellationReason {
+	p := new(CancellationReason)
+	*p = x
+	return p
+}
+
+func (x CancellationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancellationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_trip_proto_enumTypes[2].Descriptor()
+}
+
+func (CancellationReason) Type() protoreflect.EnumType {
+	return &file_trip_proto_enumTypes[2]
+}
+
+func (x CancellationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancellationReason.Descriptor instead.
+func (CancellationReason) EnumDescriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{2}
+}
+
 type PreviewTripRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	PickupLocation  *Coordinate            `protobuf:"bytes,2,opt,name=pickupLocation,proto3" json:"pickupLocation,omitempty"`
 	DropoffLocation *Coordinate            `protobuf:"bytes,3,opt,name=dropoffLocation,proto3" json:"dropoffLocation,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Intermediate stops between pickup and dropoff, in visiting order.
+	Stops         []*Stop `protobuf:"bytes,4,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PreviewTripRequest) Reset() {
@@ -37,14 +209,711 @@ func (x *PreviewTripRequest) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreviewTripRequest) String() string {
+func (x *PreviewTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTripRequest) ProtoMessage() {}
+
+func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTripRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewTripRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PreviewTripRequest) GetPickupLocation() *Coordinate {
+	if x != nil {
+		return x.PickupLocation
+	}
+	return nil
+}
+
+func (x *PreviewTripRequest) GetDropoffLocation() *Coordinate {
+	if x != nil {
+		return x.DropoffLocation
+	}
+	return nil
+}
+
+func (x *PreviewTripRequest) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type PreviewTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	Route         *Route                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	RideFares     []*RideFare            `protobuf:"bytes,3,rep,name=rideFares,proto3" json:"rideFares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTripResponse) Reset() {
+	*x = PreviewTripResponse{}
+	mi := &file_trip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTripResponse) ProtoMessage() {}
+
+func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTripResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTripResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewTripResponse) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *PreviewTripResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *PreviewTripResponse) GetRideFares() []*RideFare {
+	if x != nil {
+		return x.RideFares
+	}
+	return nil
+}
+
+type CreateTripRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RideFareID string                 `protobuf:"bytes,1,opt,name=rideFareID,proto3" json:"rideFareID,omitempty"`
+	UserID     string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Books the trip for later. Unset means the trip starts immediately.
+	ScheduledPickupTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduledPickupTime,proto3" json:"scheduledPickupTime,omitempty"`
+	// Intermediate stops between pickup and dropoff, in visiting order.
+	// Must match the stops the ride fare was previewed with.
+	Stops         []*Stop `protobuf:"bytes,4,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTripRequest) Reset() {
+	*x = CreateTripRequest{}
+	mi := &file_trip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripRequest) ProtoMessage() {}
+
+func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
+func (*CreateTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTripRequest) GetRideFareID() string {
+	if x != nil {
+		return x.RideFareID
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetScheduledPickupTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledPickupTime
+	}
+	return nil
+}
+
+func (x *CreateTripRequest) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type CreateTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	Trip          *Trip                  `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTripResponse) Reset() {
+	*x = CreateTripResponse{}
+	mi := &file_trip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripResponse) ProtoMessage() {}
+
+func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripResponse.ProtoReflect.Descriptor instead.
+func (*CreateTripResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTripResponse) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CreateTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type GetTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripRequest) Reset() {
+	*x = GetTripRequest{}
+	mi := &file_trip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripRequest) ProtoMessage() {}
+
+func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripRequest.ProtoReflect.Descriptor instead.
+func (*GetTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+type GetTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripResponse) Reset() {
+	*x = GetTripResponse{}
+	mi := &file_trip_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripResponse) ProtoMessage() {}
+
+func (x *GetTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripResponse.ProtoReflect.Descriptor instead.
+func (*GetTripResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type ListTripsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserID string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Only trips in one of these statuses are returned. Empty means all statuses.
+	Statuses      []string               `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Opaque cursor returned as nextCursor by the previous page. Empty for the first page.
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripsRequest) Reset() {
+	*x = ListTripsRequest{}
+	mi := &file_trip_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsRequest) ProtoMessage() {}
+
+func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripsRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTripsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTripsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListTripsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListTripsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTripsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListTripsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Trips []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	// Empty when there are no more pages.
+	NextCursor    string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripsResponse) Reset() {
+	*x = ListTripsResponse{}
+	mi := &file_trip_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsResponse) ProtoMessage() {}
+
+func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsResponse.ProtoReflect.Descriptor instead.
+func (*ListTripsResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTripsResponse) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+func (x *ListTripsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CancelTripRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TripID      string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	CancelledBy CancelledBy            `protobuf:"varint,2,opt,name=cancelledBy,proto3,enum=trip.CancelledBy" json:"cancelledBy,omitempty"`
+	// ID of the rider or driver who cancelled. Empty when cancelled by the system.
+	CancelledByID string             `protobuf:"bytes,3,opt,name=cancelledByID,proto3" json:"cancelledByID,omitempty"`
+	Reason        CancellationReason `protobuf:"varint,4,opt,name=reason,proto3,enum=trip.CancellationReason" json:"reason,omitempty"`
+	Comment       string             `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTripRequest) Reset() {
+	*x = CancelTripRequest{}
+	mi := &file_trip_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTripRequest) ProtoMessage() {}
+
+func (x *CancelTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTripRequest.ProtoReflect.Descriptor instead.
+func (*CancelTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CancelTripRequest) GetCancelledBy() CancelledBy {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return CancelledBy_CANCELLED_BY_UNSPECIFIED
+}
+
+func (x *CancelTripRequest) GetCancelledByID() string {
+	if x != nil {
+		return x.CancelledByID
+	}
+	return ""
+}
+
+func (x *CancelTripRequest) GetReason() CancellationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *CancelTripRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CancelTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTripResponse) Reset() {
+	*x = CancelTripResponse{}
+	mi := &file_trip_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTripResponse) ProtoMessage() {}
+
+func (x *CancelTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTripResponse.ProtoReflect.Descriptor instead.
+func (*CancelTripResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type CompleteTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	DriverID      string                 `protobuf:"bytes,2,opt,name=driverID,proto3" json:"driverID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTripRequest) Reset() {
+	*x = CompleteTripRequest{}
+	mi := &file_trip_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTripRequest) ProtoMessage() {}
+
+func (x *CompleteTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTripRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompleteTripRequest) GetTripID() string {
+	if x != nil {
+		return x.TripID
+	}
+	return ""
+}
+
+func (x *CompleteTripRequest) GetDriverID() string {
+	if x != nil {
+		return x.DriverID
+	}
+	return ""
+}
+
+type CompleteTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTripResponse) Reset() {
+	*x = CompleteTripResponse{}
+	mi := &file_trip_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTripResponse) ProtoMessage() {}
+
+func (x *CompleteTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTripResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTripResponse) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompleteTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type WatchTripRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TripID string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
+	UserID string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Resume after the last update the caller received. Status changes with a higher
+	// sequence are replayed in order; only the latest driver position is re-sent.
+	// Zero starts from the current trip state.
+	AfterSequence uint64 `protobuf:"varint,3,opt,name=afterSequence,proto3" json:"afterSequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTripRequest) Reset() {
+	*x = WatchTripRequest{}
+	mi := &file_trip_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTripRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewTripRequest) ProtoMessage() {}
+func (*WatchTripRequest) ProtoMessage() {}
 
-func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[0]
+func (x *WatchTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,56 +924,61 @@ func (x *PreviewTripRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewTripRequest.ProtoReflect.Descriptor instead.
-func (*PreviewTripRequest) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use WatchTripRequest.ProtoReflect.Descriptor instead.
+func (*WatchTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PreviewTripRequest) GetUserID() string {
+func (x *WatchTripRequest) GetTripID() string {
 	if x != nil {
-		return x.UserID
+		return x.TripID
 	}
 	return ""
 }
 
-func (x *PreviewTripRequest) GetPickupLocation() *Coordinate {
+func (x *WatchTripRequest) GetUserID() string {
 	if x != nil {
-		return x.PickupLocation
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *PreviewTripRequest) GetDropoffLocation() *Coordinate {
+func (x *WatchTripRequest) GetAfterSequence() uint64 {
 	if x != nil {
-		return x.DropoffLocation
+		return x.AfterSequence
 	}
-	return nil
+	return 0
 }
 
-type PreviewTripResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
-	Route         *Route                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	RideFares     []*RideFare            `protobuf:"bytes,3,rep,name=rideFares,proto3" json:"rideFares,omitempty"`
+type TripUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Monotonically increasing per trip.
+	Sequence  uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*TripUpdate_StatusChange
+	//	*TripUpdate_DriverPosition
+	Update        isTripUpdate_Update `protobuf_oneof:"update"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PreviewTripResponse) Reset() {
-	*x = PreviewTripResponse{}
-	mi := &file_trip_proto_msgTypes[1]
+func (x *TripUpdate) Reset() {
+	*x = TripUpdate{}
+	mi := &file_trip_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreviewTripResponse) String() string {
+func (x *TripUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewTripResponse) ProtoMessage() {}
+func (*TripUpdate) ProtoMessage() {}
 
-func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[1]
+func (x *TripUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,55 +989,90 @@ func (x *PreviewTripResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewTripResponse.ProtoReflect.Descriptor instead.
-func (*PreviewTripResponse) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TripUpdate.ProtoReflect.Descriptor instead.
+func (*TripUpdate) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PreviewTripResponse) GetTripID() string {
+func (x *TripUpdate) GetSequence() uint64 {
 	if x != nil {
-		return x.TripID
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (x *PreviewTripResponse) GetRoute() *Route {
+func (x *TripUpdate) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Route
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *PreviewTripResponse) GetRideFares() []*RideFare {
+func (x *TripUpdate) GetUpdate() isTripUpdate_Update {
 	if x != nil {
-		return x.RideFares
+		return x.Update
 	}
 	return nil
 }
 
-type CreateTripRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RideFareID    string                 `protobuf:"bytes,1,opt,name=rideFareID,proto3" json:"rideFareID,omitempty"`
-	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TripUpdate) GetStatusChange() *TripStatusChange {
+	if x != nil {
+		if x, ok := x.Update.(*TripUpdate_StatusChange); ok {
+			return x.StatusChange
+		}
+	}
+	return nil
 }
 
-func (x *CreateTripRequest) Reset() {
-	*x = CreateTripRequest{}
-	mi := &file_trip_proto_msgTypes[2]
+func (x *TripUpdate) GetDriverPosition() *TripDriverPosition {
+	if x != nil {
+		if x, ok := x.Update.(*TripUpdate_DriverPosition); ok {
+			return x.DriverPosition
+		}
+	}
+	return nil
+}
+
+type isTripUpdate_Update interface {
+	isTripUpdate_Update()
+}
+
+type TripUpdate_StatusChange struct {
+	StatusChange *TripStatusChange `protobuf:"bytes,3,opt,name=statusChange,proto3,oneof"`
+}
+
+type TripUpdate_DriverPosition struct {
+	DriverPosition *TripDriverPosition `protobuf:"bytes,4,opt,name=driverPosition,proto3,oneof"`
+}
+
+func (*TripUpdate_StatusChange) isTripUpdate_Update() {}
+
+func (*TripUpdate_DriverPosition) isTripUpdate_Update() {}
+
+type TripStatusChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PreviousStatus string                 `protobuf:"bytes,1,opt,name=previousStatus,proto3" json:"previousStatus,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Trip           *Trip                  `protobuf:"bytes,3,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TripStatusChange) Reset() {
+	*x = TripStatusChange{}
+	mi := &file_trip_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTripRequest) String() string {
+func (x *TripStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTripRequest) ProtoMessage() {}
+func (*TripStatusChange) ProtoMessage() {}
 
-func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[2]
+func (x *TripStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,48 +1083,60 @@ func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
-func (*CreateTripRequest) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TripStatusChange.ProtoReflect.Descriptor instead.
+func (*TripStatusChange) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateTripRequest) GetRideFareID() string {
+func (x *TripStatusChange) GetPreviousStatus() string {
 	if x != nil {
-		return x.RideFareID
+		return x.PreviousStatus
 	}
 	return ""
 }
 
-func (x *CreateTripRequest) GetUserID() string {
+func (x *TripStatusChange) GetStatus() string {
 	if x != nil {
-		return x.UserID
+		return x.Status
 	}
 	return ""
 }
 
-type CreateTripResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TripID        string                 `protobuf:"bytes,1,opt,name=tripID,proto3" json:"tripID,omitempty"`
-	Trip          *Trip                  `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TripStatusChange) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
 }
 
-func (x *CreateTripResponse) Reset() {
-	*x = CreateTripResponse{}
-	mi := &file_trip_proto_msgTypes[3]
+type TripDriverPosition struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Driver   *TripDriver            `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Location *Coordinate            `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Degrees clockwise from true north.
+	Heading float64 `protobuf:"fixed64,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	// Time until the driver reaches the pickup, or the dropoff once the rider is on board.
+	Eta                       *durationpb.Duration `protobuf:"bytes,4,opt,name=eta,proto3" json:"eta,omitempty"`
+	DistanceRemainingInMeters float64              `protobuf:"fixed64,5,opt,name=distanceRemainingInMeters,proto3" json:"distanceRemainingInMeters,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *TripDriverPosition) Reset() {
+	*x = TripDriverPosition{}
+	mi := &file_trip_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTripResponse) String() string {
+func (x *TripDriverPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTripResponse) ProtoMessage() {}
+func (*TripDriverPosition) ProtoMessage() {}
 
-func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[3]
+func (x *TripDriverPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,25 +1147,46 @@ func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTripResponse.ProtoReflect.Descriptor instead.
-func (*CreateTripResponse) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use TripDriverPosition.ProtoReflect.Descriptor instead.
+func (*TripDriverPosition) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateTripResponse) GetTripID() string {
+func (x *TripDriverPosition) GetDriver() *TripDriver {
 	if x != nil {
-		return x.TripID
+		return x.Driver
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTripResponse) GetTrip() *Trip {
+func (x *TripDriverPosition) GetLocation() *Coordinate {
 	if x != nil {
-		return x.Trip
+		return x.Location
+	}
+	return nil
+}
+
+func (x *TripDriverPosition) GetHeading() float64 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *TripDriverPosition) GetEta() *durationpb.Duration {
+	if x != nil {
+		return x.Eta
 	}
 	return nil
 }
 
+func (x *TripDriverPosition) GetDistanceRemainingInMeters() float64 {
+	if x != nil {
+		return x.DistanceRemainingInMeters
+	}
+	return 0
+}
+
 type Coordinate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
@@ -255,7 +1197,7 @@ type Coordinate struct {
 
 func (x *Coordinate) Reset() {
 	*x = Coordinate{}
-	mi := &file_trip_proto_msgTypes[4]
+	mi := &file_trip_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +1209,7 @@ func (x *Coordinate) String() string {
 func (*Coordinate) ProtoMessage() {}
 
 func (x *Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[4]
+	mi := &file_trip_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +1222,7 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
 func (*Coordinate) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{4}
+	return file_trip_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Coordinate) GetLatitude() float64 {
@@ -297,18 +1239,72 @@ func (x *Coordinate) GetLongitude() float64 {
 	return 0
 }
 
-type Route struct {
+type Stop struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Geometry      []*Geometry            `protobuf:"bytes,1,rep,name=geometry,proto3" json:"geometry,omitempty"`
-	Distance      float64                `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
-	Duration      float64                `protobuf:"fixed64,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Location      *Coordinate            `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	mi := &file_trip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Stop) GetLocation() *Coordinate {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Stop) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Route struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Geometry []*Geometry            `protobuf:"bytes,1,rep,name=geometry,proto3" json:"geometry,omitempty"`
+	Distance float64                `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Duration float64                `protobuf:"fixed64,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// One leg per pair of consecutive waypoints: pickup, stops, then dropoff.
+	Legs          []*RouteLeg `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_trip_proto_msgTypes[5]
+	mi := &file_trip_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +1316,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[5]
+	mi := &file_trip_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +1329,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{5}
+	return file_trip_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Route) GetGeometry() []*Geometry {
@@ -357,6 +1353,65 @@ func (x *Route) GetDuration() float64 {
 	return 0
 }
 
+func (x *Route) GetLegs() []*RouteLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type RouteLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distance      float64                `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	Duration      float64                `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteLeg) Reset() {
+	*x = RouteLeg{}
+	mi := &file_trip_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteLeg) ProtoMessage() {}
+
+func (x *RouteLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteLeg.ProtoReflect.Descriptor instead.
+func (*RouteLeg) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RouteLeg) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
 type Geometry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coordinates   []*Coordinate          `protobuf:"bytes,1,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
@@ -366,7 +1421,7 @@ type Geometry struct {
 
 func (x *Geometry) Reset() {
 	*x = Geometry{}
-	mi := &file_trip_proto_msgTypes[6]
+	mi := &file_trip_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -378,7 +1433,7 @@ func (x *Geometry) String() string {
 func (*Geometry) ProtoMessage() {}
 
 func (x *Geometry) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[6]
+	mi := &file_trip_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +1446,7 @@ func (x *Geometry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Geometry.ProtoReflect.Descriptor instead.
 func (*Geometry) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{6}
+	return file_trip_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Geometry) GetCoordinates() []*Coordinate {
@@ -402,18 +1457,23 @@ func (x *Geometry) GetCoordinates() []*Coordinate {
 }
 
 type RideFare struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserID            string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Deprecated: use vehiclePackage. Senders still set the lowercase slug (e.g. "sedan") for older readers.
+	//
+	// Deprecated: Marked as deprecated in trip.proto.
 	PackageSlug       string                 `protobuf:"bytes,3,opt,name=packageSlug,proto3" json:"packageSlug,omitempty"`
 	TotalPriceInCents float64                `protobuf:"fixed64,4,opt,name=totalPriceInCents,proto3" json:"totalPriceInCents,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	VehiclePackage    PackageSlug            `protobuf:"varint,6,opt,name=vehiclePackage,proto3,enum=trip.PackageSlug" json:"vehiclePackage,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RideFare) Reset() {
 	*x = RideFare{}
-	mi := &file_trip_proto_msgTypes[7]
+	mi := &file_trip_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +1485,7 @@ func (x *RideFare) String() string {
 func (*RideFare) ProtoMessage() {}
 
 func (x *RideFare) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[7]
+	mi := &file_trip_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +1498,7 @@ func (x *RideFare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RideFare.ProtoReflect.Descriptor instead.
 func (*RideFare) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{7}
+	return file_trip_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RideFare) GetId() string {
@@ -455,6 +1515,7 @@ func (x *RideFare) GetUserID() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in trip.proto.
 func (x *RideFare) GetPackageSlug() string {
 	if x != nil {
 		return x.PackageSlug
@@ -469,21 +1530,36 @@ func (x *RideFare) GetTotalPriceInCents() float64 {
 	return 0
 }
 
+func (x *RideFare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RideFare) GetVehiclePackage() PackageSlug {
+	if x != nil {
+		return x.VehiclePackage
+	}
+	return PackageSlug_PACKAGE_SLUG_UNSPECIFIED
+}
+
 type Trip struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SelectedFare  *RideFare              `protobuf:"bytes,2,opt,name=selectedFare,proto3" json:"selectedFare,omitempty"`
-	Route         *Route                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	UserID        string                 `protobuf:"bytes,5,opt,name=userID,proto3" json:"userID,omitempty"`
-	Driver        *TripDriver            `protobuf:"bytes,6,opt,name=driver,proto3" json:"driver,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SelectedFare        *RideFare              `protobuf:"bytes,2,opt,name=selectedFare,proto3" json:"selectedFare,omitempty"`
+	Route               *Route                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	UserID              string                 `protobuf:"bytes,5,opt,name=userID,proto3" json:"userID,omitempty"`
+	Driver              *TripDriver            `protobuf:"bytes,6,opt,name=driver,proto3" json:"driver,omitempty"`
+	ScheduledPickupTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=scheduledPickupTime,proto3" json:"scheduledPickupTime,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Trip) Reset() {
 	*x = Trip{}
-	mi := &file_trip_proto_msgTypes[8]
+	mi := &file_trip_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +1571,7 @@ func (x *Trip) String() string {
 func (*Trip) ProtoMessage() {}
 
 func (x *Trip) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[8]
+	mi := &file_trip_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +1584,7 @@ func (x *Trip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trip.ProtoReflect.Descriptor instead.
 func (*Trip) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{8}
+	return file_trip_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Trip) GetId() string {
@@ -553,19 +1629,28 @@ func (x *Trip) GetDriver() *TripDriver {
 	return nil
 }
 
+func (x *Trip) GetScheduledPickupTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledPickupTime
+	}
+	return nil
+}
+
 type TripDriver struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	ProfilePicture string                 `protobuf:"bytes,3,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
 	CarPlate       string                 `protobuf:"bytes,4,opt,name=carPlate,proto3" json:"carPlate,omitempty"`
+	Rating         float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount    int32                  `protobuf:"varint,6,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TripDriver) Reset() {
 	*x = TripDriver{}
-	mi := &file_trip_proto_msgTypes[9]
+	mi := &file_trip_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +1662,7 @@ func (x *TripDriver) String() string {
 func (*TripDriver) ProtoMessage() {}
 
 func (x *TripDriver) ProtoReflect() protoreflect.Message {
-	mi := &file_trip_proto_msgTypes[9]
+	mi := &file_trip_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +1675,7 @@ func (x *TripDriver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TripDriver.ProtoReflect.Descriptor instead.
 func (*TripDriver) Descriptor() ([]byte, []int) {
-	return file_trip_proto_rawDescGZIP(), []int{9}
+	return file_trip_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TripDriver) GetId() string {
@@ -621,61 +1706,172 @@ func (x *TripDriver) GetCarPlate() string {
 	return ""
 }
 
+func (x *TripDriver) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TripDriver) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 var File_trip_proto protoreflect.FileDescriptor
 
 const file_trip_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"trip.proto\x12\x04trip\"\xa2\x01\n" +
+	"trip.proto\x12\x04trip\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\x01\n" +
 	"\x12PreviewTripRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x128\n" +
 	"\x0epickupLocation\x18\x02 \x01(\v2\x10.trip.CoordinateR\x0epickupLocation\x12:\n" +
-	"\x0fdropoffLocation\x18\x03 \x01(\v2\x10.trip.CoordinateR\x0fdropoffLocation\"~\n" +
+	"\x0fdropoffLocation\x18\x03 \x01(\v2\x10.trip.CoordinateR\x0fdropoffLocation\x12 \n" +
+	"\x05stops\x18\x04 \x03(\v2\n" +
+	".trip.StopR\x05stops\"~\n" +
 	"\x13PreviewTripResponse\x12\x16\n" +
 	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12!\n" +
 	"\x05route\x18\x02 \x01(\v2\v.trip.RouteR\x05route\x12,\n" +
-	"\trideFares\x18\x03 \x03(\v2\x0e.trip.RideFareR\trideFares\"K\n" +
+	"\trideFares\x18\x03 \x03(\v2\x0e.trip.RideFareR\trideFares\"\xbb\x01\n" +
 	"\x11CreateTripRequest\x12\x1e\n" +
 	"\n" +
 	"rideFareID\x18\x01 \x01(\tR\n" +
 	"rideFareID\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\"L\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12L\n" +
+	"\x13scheduledPickupTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x13scheduledPickupTime\x12 \n" +
+	"\x05stops\x18\x04 \x03(\v2\n" +
+	".trip.StopR\x05stops\"L\n" +
 	"\x12CreateTripResponse\x12\x16\n" +
 	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1e\n" +
 	"\x04trip\x18\x02 \x01(\v2\n" +
-	".trip.TripR\x04trip\"F\n" +
+	".trip.TripR\x04trip\"(\n" +
+	"\x0eGetTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\"1\n" +
+	"\x0fGetTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"\xfc\x01\n" +
+	"\x10ListTripsRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x12>\n" +
+	"\fcreatedAfter\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12@\n" +
+	"\rcreatedBefore\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"U\n" +
+	"\x11ListTripsResponse\x12 \n" +
+	"\x05trips\x18\x01 \x03(\v2\n" +
+	".trip.TripR\x05trips\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xd2\x01\n" +
+	"\x11CancelTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x123\n" +
+	"\vcancelledBy\x18\x02 \x01(\x0e2\x11.trip.CancelledByR\vcancelledBy\x12$\n" +
+	"\rcancelledByID\x18\x03 \x01(\tR\rcancelledByID\x120\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x18.trip.CancellationReasonR\x06reason\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"4\n" +
+	"\x12CancelTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"I\n" +
+	"\x13CompleteTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x1a\n" +
+	"\bdriverID\x18\x02 \x01(\tR\bdriverID\"6\n" +
+	"\x14CompleteTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"h\n" +
+	"\x10WatchTripRequest\x12\x16\n" +
+	"\x06tripID\x18\x01 \x01(\tR\x06tripID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12$\n" +
+	"\rafterSequence\x18\x03 \x01(\x04R\rafterSequence\"\xee\x01\n" +
+	"\n" +
+	"TripUpdate\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12<\n" +
+	"\fstatusChange\x18\x03 \x01(\v2\x16.trip.TripStatusChangeH\x00R\fstatusChange\x12B\n" +
+	"\x0edriverPosition\x18\x04 \x01(\v2\x18.trip.TripDriverPositionH\x00R\x0edriverPositionB\b\n" +
+	"\x06update\"r\n" +
+	"\x10TripStatusChange\x12&\n" +
+	"\x0epreviousStatus\x18\x01 \x01(\tR\x0epreviousStatus\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1e\n" +
+	"\x04trip\x18\x03 \x01(\v2\n" +
+	".trip.TripR\x04trip\"\xf1\x01\n" +
+	"\x12TripDriverPosition\x12(\n" +
+	"\x06driver\x18\x01 \x01(\v2\x10.trip.TripDriverR\x06driver\x12,\n" +
+	"\blocation\x18\x02 \x01(\v2\x10.trip.CoordinateR\blocation\x12\x18\n" +
+	"\aheading\x18\x03 \x01(\x01R\aheading\x12+\n" +
+	"\x03eta\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03eta\x12<\n" +
+	"\x19distanceRemainingInMeters\x18\x05 \x01(\x01R\x19distanceRemainingInMeters\"F\n" +
 	"\n" +
 	"Coordinate\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"k\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"N\n" +
+	"\x04Stop\x12,\n" +
+	"\blocation\x18\x01 \x01(\v2\x10.trip.CoordinateR\blocation\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x8f\x01\n" +
 	"\x05Route\x12*\n" +
 	"\bgeometry\x18\x01 \x03(\v2\x0e.trip.GeometryR\bgeometry\x12\x1a\n" +
 	"\bdistance\x18\x02 \x01(\x01R\bdistance\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\x01R\bduration\">\n" +
+	"\bduration\x18\x03 \x01(\x01R\bduration\x12\"\n" +
+	"\x04legs\x18\x04 \x03(\v2\x0e.trip.RouteLegR\x04legs\"B\n" +
+	"\bRouteLeg\x12\x1a\n" +
+	"\bdistance\x18\x01 \x01(\x01R\bdistance\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x01R\bduration\">\n" +
 	"\bGeometry\x122\n" +
-	"\vcoordinates\x18\x01 \x03(\v2\x10.trip.CoordinateR\vcoordinates\"\x82\x01\n" +
+	"\vcoordinates\x18\x01 \x03(\v2\x10.trip.CoordinateR\vcoordinates\"\xfb\x01\n" +
 	"\bRideFare\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\x12 \n" +
-	"\vpackageSlug\x18\x03 \x01(\tR\vpackageSlug\x12,\n" +
-	"\x11totalPriceInCents\x18\x04 \x01(\x01R\x11totalPriceInCents\"\xc7\x01\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12$\n" +
+	"\vpackageSlug\x18\x03 \x01(\tB\x02\x18\x01R\vpackageSlug\x12,\n" +
+	"\x11totalPriceInCents\x18\x04 \x01(\x01R\x11totalPriceInCents\x128\n" +
+	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\x0evehiclePackage\x18\x06 \x01(\x0e2\x11.trip.PackageSlugR\x0evehiclePackage\"\x95\x02\n" +
 	"\x04Trip\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\fselectedFare\x18\x02 \x01(\v2\x0e.trip.RideFareR\fselectedFare\x12!\n" +
 	"\x05route\x18\x03 \x01(\v2\v.trip.RouteR\x05route\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
 	"\x06userID\x18\x05 \x01(\tR\x06userID\x12(\n" +
-	"\x06driver\x18\x06 \x01(\v2\x10.trip.TripDriverR\x06driver\"t\n" +
+	"\x06driver\x18\x06 \x01(\v2\x10.trip.TripDriverR\x06driver\x12L\n" +
+	"\x13scheduledPickupTime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x13scheduledPickupTime\"\xae\x01\n" +
 	"\n" +
 	"TripDriver\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
 	"\x0eprofilePicture\x18\x03 \x01(\tR\x0eprofilePicture\x12\x1a\n" +
-	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate2\x92\x01\n" +
+	"\bcarPlate\x18\x04 \x01(\tR\bcarPlate\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x01R\x06rating\x12 \n" +
+	"\vratingCount\x18\x06 \x01(\x05R\vratingCount*r\n" +
+	"\vPackageSlug\x12\x1c\n" +
+	"\x18PACKAGE_SLUG_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PACKAGE_SLUG_SEDAN\x10\x01\x12\x17\n" +
+	"\x13PACKAGE_SLUG_LUXURY\x10\x02\x12\x14\n" +
+	"\x10PACKAGE_SLUG_VAN\x10\x03*u\n" +
+	"\vCancelledBy\x12\x1c\n" +
+	"\x18CANCELLED_BY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CANCELLED_BY_RIDER\x10\x01\x12\x17\n" +
+	"\x13CANCELLED_BY_DRIVER\x10\x02\x12\x17\n" +
+	"\x13CANCELLED_BY_SYSTEM\x10\x03*\xc8\x02\n" +
+	"\x12CancellationReason\x12#\n" +
+	"\x1fCANCELLATION_REASON_UNSPECIFIED\x10\x00\x12%\n" +
+	"!CANCELLATION_REASON_CHANGED_PLANS\x10\x01\x12&\n" +
+	"\"CANCELLATION_REASON_DRIVER_TOO_FAR\x10\x02\x12&\n" +
+	"\"CANCELLATION_REASON_DRIVER_NO_SHOW\x10\x03\x12%\n" +
+	"!CANCELLATION_REASON_RIDER_NO_SHOW\x10\x04\x12(\n" +
+	"$CANCELLATION_REASON_NO_DRIVERS_FOUND\x10\x05\x12&\n" +
+	"\"CANCELLATION_REASON_PAYMENT_FAILED\x10\x06\x12\x1d\n" +
+	"\x19CANCELLATION_REASON_OTHER\x10\a2\xc9\x03\n" +
 	"\vTripService\x12B\n" +
 	"\vPreviewTrip\x12\x18.trip.PreviewTripRequest\x1a\x19.trip.PreviewTripResponse\x12?\n" +
 	"\n" +
-	"CreateTrip\x12\x17.trip.CreateTripRequest\x1a\x18.trip.CreateTripResponseB*Z(github.com/ride4Low/contracts/proto/tripb\x06proto3"
+	"CreateTrip\x12\x17.trip.CreateTripRequest\x1a\x18.trip.CreateTripResponse\x126\n" +
+	"\aGetTrip\x12\x14.trip.GetTripRequest\x1a\x15.trip.GetTripResponse\x12<\n" +
+	"\tListTrips\x12\x16.trip.ListTripsRequest\x1a\x17.trip.ListTripsResponse\x12?\n" +
+	"\n" +
+	"CancelTrip\x12\x17.trip.CancelTripRequest\x1a\x18.trip.CancelTripResponse\x12E\n" +
+	"\fCompleteTrip\x12\x19.trip.CompleteTripRequest\x1a\x1a.trip.CompleteTripResponse\x127\n" +
+	"\tWatchTrip\x12\x16.trip.WatchTripRequest\x1a\x10.trip.TripUpdate0\x01B*Z(github.com/ride4Low/contracts/proto/tripb\x06proto3"
 
 var (
 	file_trip_proto_rawDescOnce sync.Once
@@ -689,39 +1885,92 @@ func file_trip_proto_rawDescGZIP() []byte {
 	return file_trip_proto_rawDescData
 }
 
-var file_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_trip_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_trip_proto_goTypes = []any{
-	(*PreviewTripRequest)(nil),  // 0: trip.PreviewTripRequest
-	(*PreviewTripResponse)(nil), // 1: trip.PreviewTripResponse
-	(*CreateTripRequest)(nil),   // 2: trip.CreateTripRequest
-	(*CreateTripResponse)(nil),  // 3: trip.CreateTripResponse
-	(*Coordinate)(nil),          // 4: trip.Coordinate
-	(*Route)(nil),               // 5: trip.Route
-	(*Geometry)(nil),            // 6: trip.Geometry
-	(*RideFare)(nil),            // 7: trip.RideFare
-	(*Trip)(nil),                // 8: trip.Trip
-	(*TripDriver)(nil),          // 9: trip.TripDriver
+	(PackageSlug)(0),              // 0: trip.PackageSlug
+	(CancelledBy)(0),              // 1: trip.CancelledBy
+	(CancellationReason)(0),       // 2: trip.CancellationReason
+	(*PreviewTripRequest)(nil),    // 3: trip.PreviewTripRequest
+	(*PreviewTripResponse)(nil),   // 4: trip.PreviewTripResponse
+	(*CreateTripRequest)(nil),     // 5: trip.CreateTripRequest
+	(*CreateTripResponse)(nil),    // 6: trip.CreateTripResponse
+	(*GetTripRequest)(nil),        // 7: trip.GetTripRequest
+	(*GetTripResponse)(nil),       // 8: trip.GetTripResponse
+	(*ListTripsRequest)(nil),      // 9: trip.ListTripsRequest
+	(*ListTripsResponse)(nil),     // 10: trip.ListTripsResponse
+	(*CancelTripRequest)(nil),     // 11: trip.CancelTripRequest
+	(*CancelTripResponse)(nil),    // 12: trip.CancelTripResponse
+	(*CompleteTripRequest)(nil),   // 13: trip.CompleteTripRequest
+	(*CompleteTripResponse)(nil),  // 14: trip.CompleteTripResponse
+	(*WatchTripRequest)(nil),      // 15: trip.WatchTripRequest
+	(*TripUpdate)(nil),            // 16: trip.TripUpdate
+	(*TripStatusChange)(nil),      // 17: trip.TripStatusChange
+	(*TripDriverPosition)(nil),    // 18: trip.TripDriverPosition
+	(*Coordinate)(nil),            // 19: trip.Coordinate
+	(*Stop)(nil),                  // 20: trip.Stop
+	(*Route)(nil),                 // 21: trip.Route
+	(*RouteLeg)(nil),              // 22: trip.RouteLeg
+	(*Geometry)(nil),              // 23: trip.Geometry
+	(*RideFare)(nil),              // 24: trip.RideFare
+	(*Trip)(nil),                  // 25: trip.Trip
+	(*TripDriver)(nil),            // 26: trip.TripDriver
+	(*timestamppb.Timestamp)(nil), // 27: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 28: google.protobuf.Duration
 }
 var file_trip_proto_depIdxs = []int32{
-	4,  // 0: trip.PreviewTripRequest.pickupLocation:type_name -> trip.Coordinate
-	4,  // 1: trip.PreviewTripRequest.dropoffLocation:type_name -> trip.Coordinate
-	5,  // 2: trip.PreviewTripResponse.route:type_name -> trip.Route
-	7,  // 3: trip.PreviewTripResponse.rideFares:type_name -> trip.RideFare
-	8,  // 4: trip.CreateTripResponse.trip:type_name -> trip.Trip
-	6,  // 5: trip.Route.geometry:type_name -> trip.Geometry
-	4,  // 6: trip.Geometry.coordinates:type_name -> trip.Coordinate
-	7,  // 7: trip.Trip.selectedFare:type_name -> trip.RideFare
-	5,  // 8: trip.Trip.route:type_name -> trip.Route
-	9,  // 9: trip.Trip.driver:type_name -> trip.TripDriver
-	0,  // 10: trip.TripService.PreviewTrip:input_type -> trip.PreviewTripRequest
-	2,  // 11: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
-	1,  // 12: trip.TripService.PreviewTrip:output_type -> trip.PreviewTripResponse
-	3,  // 13: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
-	12, // [12:14] is the sub-list for method output_type
-	10, // [10:12] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	19, // 0: trip.PreviewTripRequest.pickupLocation:type_name -> trip.Coordinate
+	19, // 1: trip.PreviewTripRequest.dropoffLocation:type_name -> trip.Coordinate
+	20, // 2: trip.PreviewTripRequest.stops:type_name -> trip.Stop
+	21, // 3: trip.PreviewTripResponse.route:type_name -> trip.Route
+	24, // 4: trip.PreviewTripResponse.rideFares:type_name -> trip.RideFare
+	27, // 5: trip.CreateTripRequest.scheduledPickupTime:type_name -> google.protobuf.Timestamp
+	20, // 6: trip.CreateTripRequest.stops:type_name -> trip.Stop
+	25, // 7: trip.CreateTripResponse.trip:type_name -> trip.Trip
+	25, // 8: trip.GetTripResponse.trip:type_name -> trip.Trip
+	27, // 9: trip.ListTripsRequest.createdAfter:type_name -> google.protobuf.Timestamp
+	27, // 10: trip.ListTripsRequest.createdBefore:type_name -> google.protobuf.Timestamp
+	25, // 11: trip.ListTripsResponse.trips:type_name -> trip.Trip
+	1,  // 12: trip.CancelTripRequest.cancelledBy:type_name -> trip.CancelledBy
+	2,  // 13: trip.CancelTripRequest.reason:type_name -> trip.CancellationReason
+	25, // 14: trip.CancelTripResponse.trip:type_name -> trip.Trip
+	25, // 15: trip.CompleteTripResponse.trip:type_name -> trip.Trip
+	27, // 16: trip.TripUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	17, // 17: trip.TripUpdate.statusChange:type_name -> trip.TripStatusChange
+	18, // 18: trip.TripUpdate.driverPosition:type_name -> trip.TripDriverPosition
+	25, // 19: trip.TripStatusChange.trip:type_name -> trip.Trip
+	26, // 20: trip.TripDriverPosition.driver:type_name -> trip.TripDriver
+	19, // 21: trip.TripDriverPosition.location:type_name -> trip.Coordinate
+	28, // 22: trip.TripDriverPosition.eta:type_name -> google.protobuf.Duration
+	19, // 23: trip.Stop.location:type_name -> trip.Coordinate
+	23, // 24: trip.Route.geometry:type_name -> trip.Geometry
+	22, // 25: trip.Route.legs:type_name -> trip.RouteLeg
+	19, // 26: trip.Geometry.coordinates:type_name -> trip.Coordinate
+	27, // 27: trip.RideFare.createdAt:type_name -> google.protobuf.Timestamp
+	0,  // 28: trip.RideFare.vehiclePackage:type_name -> trip.PackageSlug
+	24, // 29: trip.Trip.selectedFare:type_name -> trip.RideFare
+	21, // 30: trip.Trip.route:type_name -> trip.Route
+	26, // 31: trip.Trip.driver:type_name -> trip.TripDriver
+	27, // 32: trip.Trip.scheduledPickupTime:type_name -> google.protobuf.Timestamp
+	3,  // 33: trip.TripService.PreviewTrip:input_type -> trip.PreviewTripRequest
+	5,  // 34: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
+	7,  // 35: trip.TripService.GetTrip:input_type -> trip.GetTripRequest
+	9,  // 36: trip.TripService.ListTrips:input_type -> trip.ListTripsRequest
+	11, // 37: trip.TripService.CancelTrip:input_type -> trip.CancelTripRequest
+	13, // 38: trip.TripService.CompleteTrip:input_type -> trip.CompleteTripRequest
+	15, // 39: trip.TripService.WatchTrip:input_type -> trip.WatchTripRequest
+	4,  // 40: trip.TripService.PreviewTrip:output_type -> trip.PreviewTripResponse
+	6,  // 41: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
+	8,  // 42: trip.TripService.GetTrip:output_type -> trip.GetTripResponse
+	10, // 43: trip.TripService.ListTrips:output_type -> trip.ListTripsResponse
+	12, // 44: trip.TripService.CancelTrip:output_type -> trip.CancelTripResponse
+	14, // 45: trip.TripService.CompleteTrip:output_type -> trip.CompleteTripResponse
+	16, // 46: trip.TripService.WatchTrip:output_type -> trip.TripUpdate
+	40, // [40:47] is the sub-list for method output_type
+	33, // [33:40] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_trip_proto_init() }
@@ -729,18 +1978,23 @@ func file_trip_proto_init() {
 	if File_trip_proto != nil {
 		return
 	}
+	file_trip_proto_msgTypes[13].OneofWrappers = []any{
+		(*TripUpdate_StatusChange)(nil),
+		(*TripUpdate_DriverPosition)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_trip_proto_rawDesc), len(file_trip_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_trip_proto_goTypes,
 		DependencyIndexes: file_trip_proto_depIdxs,
+		EnumInfos:         file_trip_proto_enumTypes,
 		MessageInfos:      file_trip_proto_msgTypes,
 	}.Build()
 	File_trip_proto = out.File
@@ -19,8 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TripService_PreviewTrip_FullMethodName = "/trip.TripService/PreviewTrip"
-	TripService_CreateTrip_FullMethodName  = "/trip.TripService/CreateTrip"
+	TripService_PreviewTrip_FullMethodName  = "/trip.TripService/PreviewTrip"
+	TripService_CreateTrip_FullMethodName   = "/trip.TripService/CreateTrip"
+	TripService_GetTrip_FullMethodName      = "/trip.TripService/GetTrip"
+	TripService_ListTrips_FullMethodName    = "/trip.TripService/ListTrips"
+	TripService_CancelTrip_FullMethodName   = "/trip.TripService/CancelTrip"
+	TripService_CompleteTrip_FullMethodName = "/trip.TripService/CompleteTrip"
+	TripService_WatchTrip_FullMethodName    = "/trip.TripService/WatchTrip"
 )
 
 // TripServiceClient is the client API for TripService service.
@@ -29,6 +34,11 @@ const (
 type TripServiceClient interface {
 	PreviewTrip(ctx context.Context, in *PreviewTripRequest, opts ...grpc.CallOption) (*PreviewTripResponse, error)
 	CreateTrip(ctx context.Context, in *CreateTripRequest, opts ...grpc.CallOption) (*CreateTripResponse, error)
+	GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*GetTripResponse, error)
+	ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error)
+	CancelTrip(ctx context.Context, in *CancelTripRequest, opts ...grpc.CallOption) (*CancelTripResponse, error)
+	CompleteTrip(ctx context.Context, in *CompleteTripRequest, opts ...grpc.CallOption) (*CompleteTripResponse, error)
+	WatchTrip(ctx context.Context, in *WatchTripRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TripUpdate], error)
 }
 
 type tripServiceClient struct {
@@ -59,12 +69,76 @@ func (c *tripServiceClient) CreateTrip(ctx context.Context, in *CreateTripReques
 	return out, nil
 }
 
+func (c *tripServiceClient) GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*GetTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTripResponse)
+	err := c.cc.Invoke(ctx, TripService_GetTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTripsResponse)
+	err := c.cc.Invoke(ctx, TripService_ListTrips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CancelTrip(ctx context.Context, in *CancelTripRequest, opts ...grpc.CallOption) (*CancelTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTripResponse)
+	err := c.cc.Invoke(ctx, TripService_CancelTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CompleteTrip(ctx context.Context, in *CompleteTripRequest, opts ...grpc.CallOption) (*CompleteTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTripResponse)
+	err := c.cc.Invoke(ctx, TripService_CompleteTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) WatchTrip(ctx context.Context, in *WatchTripRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TripUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TripService_ServiceDesc.Streams[0], TripService_WatchTrip_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTripRequest, TripUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_WatchTripClient = grpc.ServerStreamingClient[TripUpdate]
+
 // TripServiceServer is the server API for TripService service.
 // All implementations must embed UnimplementedTripServiceServer
 // for forward compatibility.
 type TripServiceServer interface {
 	PreviewTrip(context.Context, *PreviewTripRequest) (*PreviewTripResponse, error)
 	CreateTrip(context.Context, *CreateTripRequest) (*CreateTripResponse, error)
+	GetTrip(context.Context, *GetTripRequest) (*GetTripResponse, error)
+	ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error)
+	CancelTrip(context.Context, *CancelTripRequest) (*CancelTripResponse, error)
+	CompleteTrip(context.Context, *CompleteTripRequest) (*CompleteTripResponse, error)
+	WatchTrip(*WatchTripRequest, grpc.ServerStreamingServer[TripUpdate]) error
 	mustEmbedUnimplementedTripServiceServer()
 }
 
@@ -81,6 +155,21 @@ func (UnimplementedTripServiceServer) PreviewTrip(context.Context, *PreviewTripR
 func (UnimplementedTripServiceServer) CreateTrip(context.Context, *CreateTripRequest) (*CreateTripResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrip not implemented")
 }
+func (UnimplementedTripServiceServer) GetTrip(context.Context, *GetTripRequest) (*GetTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrip not implemented")
+}
+func (UnimplementedTripServiceServer) ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrips not implemented")
+}
+func (UnimplementedTripServiceServer) CancelTrip(context.Context, *CancelTripRequest) (*CancelTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrip not implemented")
+}
+func (UnimplementedTripServiceServer) CompleteTrip(context.Context, *CompleteTripRequest) (*CompleteTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTrip not implemented")
+}
+func (UnimplementedTripServiceServer) WatchTrip(*WatchTripRequest, grpc.ServerStreamingServer[TripUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTrip not implemented")
+}
 func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
 func (UnimplementedTripServiceServer) testEmbeddedByValue()                     {}
 
@@ -138,6 +227,89 @@ func _TripService_CreateTrip_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TripService_GetTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTrip(ctx, req.(*GetTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListTrips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListTrips(ctx, req.(*ListTripsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CancelTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CancelTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_CancelTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CancelTrip(ctx, req.(*CancelTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CompleteTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CompleteTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_CompleteTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CompleteTrip(ctx, req.(*CompleteTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_WatchTrip_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTripRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TripServiceServer).WatchTrip(m, &grpc.GenericServerStream[WatchTripRequest, TripUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_WatchTripServer = grpc.ServerStreamingServer[TripUpdate]
+
 // TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +325,29 @@ var TripService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTrip",
 			Handler:    _TripService_CreateTrip_Handler,
 		},
+		{
+			MethodName: "GetTrip",
+			Handler:    _TripService_GetTrip_Handler,
+		},
+		{
+			MethodName: "ListTrips",
+			Handler:    _TripService_ListTrips_Handler,
+		},
+		{
+			MethodName: "CancelTrip",
+			Handler:    _TripService_CancelTrip_Handler,
+		},
+		{
+			MethodName: "CompleteTrip",
+			Handler:    _TripService_CompleteTrip_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTrip",
+			Handler:       _TripService_WatchTrip_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "trip.proto",
 }
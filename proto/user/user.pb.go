@@ -0,0 +1,1005 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: user.proto
+
+package user
+
+import (
+	payment "github.com/ride4Low/contracts/proto/payment"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlaceType int32
+
+const (
+	PlaceType_PLACE_TYPE_UNSPECIFIED PlaceType = 0
+	PlaceType_PLACE_TYPE_HOME        PlaceType = 1
+	PlaceType_PLACE_TYPE_WORK        PlaceType = 2
+	PlaceType_PLACE_TYPE_OTHER       PlaceType = 3
+)
+
+// Enum value maps for PlaceType.
+var (
+	PlaceType_name = map[int32]string{
+		0: "PLACE_TYPE_UNSPECIFIED",
+		1: "PLACE_TYPE_HOME",
+		2: "PLACE_TYPE_WORK",
+		3: "PLACE_TYPE_OTHER",
+	}
+	PlaceType_value = map[string]int32{
+		"PLACE_TYPE_UNSPECIFIED": 0,
+		"PLACE_TYPE_HOME":        1,
+		"PLACE_TYPE_WORK":        2,
+		"PLACE_TYPE_OTHER":       3,
+	}
+)
+
+func (x PlaceType) Enum() *PlaceType {
+	p := new(PlaceType)
+	*p = x
+	return p
+}
+
+func (x PlaceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[0].Descriptor()
+}
+
+func (PlaceType) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[0]
+}
+
+func (x PlaceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaceType.Descriptor instead.
+func (PlaceType) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+type Place struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  PlaceType              `protobuf:"varint,2,opt,name=type,proto3,enum=user.PlaceType" json:"type,omitempty"`
+	// Display name, e.g. "Gym". Defaults to the type for home and work.
+	Label         string  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Address       string  `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	mi := &file_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Place) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Place) GetType() PlaceType {
+	if x != nil {
+		return x.Type
+	}
+	return PlaceType_PLACE_TYPE_UNSPECIFIED
+}
+
+func (x *Place) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Place) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Place) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Place) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Profile struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserID               string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber          string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	ProfilePicture       string                 `protobuf:"bytes,5,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
+	Rating               float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount          int32                  `protobuf:"varint,7,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	DefaultPaymentMethod payment.PaymentMethod  `protobuf:"varint,8,opt,name=defaultPaymentMethod,proto3,enum=payment.PaymentMethod" json:"defaultPaymentMethod,omitempty"`
+	SavedPlaces          []*Place               `protobuf:"bytes,9,rep,name=savedPlaces,proto3" json:"savedPlaces,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Profile) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Profile) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Profile) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *Profile) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Profile) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Profile) GetDefaultPaymentMethod() payment.PaymentMethod {
+	if x != nil {
+		return x.DefaultPaymentMethod
+	}
+	return payment.PaymentMethod(0)
+}
+
+func (x *Profile) GetSavedPlaces() []*Place {
+	if x != nil {
+		return x.SavedPlaces
+	}
+	return nil
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// RiderSummary is the subset of a profile shared with the driver picking the rider up.
+type RiderSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProfilePicture string                 `protobuf:"bytes,3,opt,name=profilePicture,proto3" json:"profilePicture,omitempty"`
+	Rating         float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount    int32                  `protobuf:"varint,5,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RiderSummary) Reset() {
+	*x = RiderSummary{}
+	mi := &file_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiderSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiderSummary) ProtoMessage() {}
+
+func (x *RiderSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiderSummary.ProtoReflect.Descriptor instead.
+func (*RiderSummary) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RiderSummary) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RiderSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RiderSummary) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *RiderSummary) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RiderSummary) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProfileRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Unset fields are left unchanged.
+type UpdateProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Email          *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	PhoneNumber    *string                `protobuf:"bytes,4,opt,name=phoneNumber,proto3,oneof" json:"phoneNumber,omitempty"`
+	ProfilePicture *string                `protobuf:"bytes,5,opt,name=profilePicture,proto3,oneof" json:"profilePicture,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProfileRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhoneNumber() string {
+	if x != nil && x.PhoneNumber != nil {
+		return *x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetProfilePicture() string {
+	if x != nil && x.ProfilePicture != nil {
+		return *x.ProfilePicture
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Creates the place when place.id is empty, replaces it otherwise.
+// Saving a home or work place replaces the existing one of that type.
+type SavePlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Place         *Place                 `protobuf:"bytes,2,opt,name=place,proto3" json:"place,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlaceRequest) Reset() {
+	*x = SavePlaceRequest{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlaceRequest) ProtoMessage() {}
+
+func (x *SavePlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlaceRequest.ProtoReflect.Descriptor instead.
+func (*SavePlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SavePlaceRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SavePlaceRequest) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+type SavePlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Place         *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlaceResponse) Reset() {
+	*x = SavePlaceResponse{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlaceResponse) ProtoMessage() {}
+
+func (x *SavePlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlaceResponse.ProtoReflect.Descriptor instead.
+func (*SavePlaceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SavePlaceResponse) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+type DeletePlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaceID       string                 `protobuf:"bytes,2,opt,name=placeID,proto3" json:"placeID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaceRequest) Reset() {
+	*x = DeletePlaceRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaceRequest) ProtoMessage() {}
+
+func (x *DeletePlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaceRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePlaceRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeletePlaceRequest) GetPlaceID() string {
+	if x != nil {
+		return x.PlaceID
+	}
+	return ""
+}
+
+type DeletePlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaceResponse) Reset() {
+	*x = DeletePlaceResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaceResponse) ProtoMessage() {}
+
+func (x *DeletePlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaceResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+type SetDefaultPaymentMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PaymentMethod payment.PaymentMethod  `protobuf:"varint,2,opt,name=paymentMethod,proto3,enum=payment.PaymentMethod" json:"paymentMethod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultPaymentMethodRequest) Reset() {
+	*x = SetDefaultPaymentMethodRequest{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPaymentMethodRequest) ProtoMessage() {}
+
+func (x *SetDefaultPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetDefaultPaymentMethodRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetDefaultPaymentMethodRequest) GetPaymentMethod() payment.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return payment.PaymentMethod(0)
+}
+
+type SetDefaultPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultPaymentMethodResponse) Reset() {
+	*x = SetDefaultPaymentMethodResponse{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPaymentMethodResponse) ProtoMessage() {}
+
+func (x *SetDefaultPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetDefaultPaymentMethodResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rpayment.proto\"\xa6\x01\n" +
+	"\x05Place\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.user.PlaceTypeR\x04type\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\"\x84\x03\n" +
+	"\aProfile\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12 \n" +
+	"\vphoneNumber\x18\x04 \x01(\tR\vphoneNumber\x12&\n" +
+	"\x0eprofilePicture\x18\x05 \x01(\tR\x0eprofilePicture\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x01R\x06rating\x12 \n" +
+	"\vratingCount\x18\a \x01(\x05R\vratingCount\x12J\n" +
+	"\x14defaultPaymentMethod\x18\b \x01(\x0e2\x16.payment.PaymentMethodR\x14defaultPaymentMethod\x12-\n" +
+	"\vsavedPlaces\x18\t \x03(\v2\v.user.PlaceR\vsavedPlaces\x128\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9c\x01\n" +
+	"\fRiderSummary\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0eprofilePicture\x18\x03 \x01(\tR\x0eprofilePicture\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12 \n" +
+	"\vratingCount\x18\x05 \x01(\x05R\vratingCount\"+\n" +
+	"\x11GetProfileRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"=\n" +
+	"\x12GetProfileResponse\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\r.user.ProfileR\aprofile\"\xec\x01\n" +
+	"\x14UpdateProfileRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x12%\n" +
+	"\vphoneNumber\x18\x04 \x01(\tH\x02R\vphoneNumber\x88\x01\x01\x12+\n" +
+	"\x0eprofilePicture\x18\x05 \x01(\tH\x03R\x0eprofilePicture\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_emailB\x0e\n" +
+	"\f_phoneNumberB\x11\n" +
+	"\x0f_profilePicture\"@\n" +
+	"\x15UpdateProfileResponse\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\r.user.ProfileR\aprofile\"M\n" +
+	"\x10SavePlaceRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12!\n" +
+	"\x05place\x18\x02 \x01(\v2\v.user.PlaceR\x05place\"6\n" +
+	"\x11SavePlaceResponse\x12!\n" +
+	"\x05place\x18\x01 \x01(\v2\v.user.PlaceR\x05place\"F\n" +
+	"\x12DeletePlaceRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
+	"\aplaceID\x18\x02 \x01(\tR\aplaceID\"\x15\n" +
+	"\x13DeletePlaceResponse\"v\n" +
+	"\x1eSetDefaultPaymentMethodRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12<\n" +
+	"\rpaymentMethod\x18\x02 \x01(\x0e2\x16.payment.PaymentMethodR\rpaymentMethod\"J\n" +
+	"\x1fSetDefaultPaymentMethodResponse\x12'\n" +
+	"\aprofile\x18\x01 \x01(\v2\r.user.ProfileR\aprofile*g\n" +
+	"\tPlaceType\x12\x1a\n" +
+	"\x16PLACE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPLACE_TYPE_HOME\x10\x01\x12\x13\n" +
+	"\x0fPLACE_TYPE_WORK\x10\x02\x12\x14\n" +
+	"\x10PLACE_TYPE_OTHER\x10\x032\x82\x03\n" +
+	"\vUserService\x12?\n" +
+	"\n" +
+	"GetProfile\x12\x17.user.GetProfileRequest\x1a\x18.user.GetProfileResponse\x12H\n" +
+	"\rUpdateProfile\x12\x1a.user.UpdateProfileRequest\x1a\x1b.user.UpdateProfileResponse\x12<\n" +
+	"\tSavePlace\x12\x16.user.SavePlaceRequest\x1a\x17.user.SavePlaceResponse\x12B\n" +
+	"\vDeletePlace\x12\x18.user.DeletePlaceRequest\x1a\x19.user.DeletePlaceResponse\x12f\n" +
+	"\x17SetDefaultPaymentMethod\x12$.user.SetDefaultPaymentMethodRequest\x1a%.user.SetDefaultPaymentMethodResponseB*Z(github.com/ride4Low/contracts/proto/userb\x06proto3"
+
+var (
+	file_user_proto_rawDescOnce sync.Once
+	file_user_proto_rawDescData []byte
+)
+
+func file_user_proto_rawDescGZIP() []byte {
+	file_user_proto_rawDescOnce.Do(func() {
+		file_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)))
+	})
+	return file_user_proto_rawDescData
+}
+
+var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_user_proto_goTypes = []any{
+	(PlaceType)(0),                          // 0: user.PlaceType
+	(*Place)(nil),                           // 1: user.Place
+	(*Profile)(nil),                         // 2: user.Profile
+	(*RiderSummary)(nil),                    // 3: user.RiderSummary
+	(*GetProfileRequest)(nil),               // 4: user.GetProfileRequest
+	(*GetProfileResponse)(nil),              // 5: user.GetProfileResponse
+	(*UpdateProfileRequest)(nil),            // 6: user.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 7: user.UpdateProfileResponse
+	(*SavePlaceRequest)(nil),                // 8: user.SavePlaceRequest
+	(*SavePlaceResponse)(nil),               // 9: user.SavePlaceResponse
+	(*DeletePlaceRequest)(nil),              // 10: user.DeletePlaceRequest
+	(*DeletePlaceResponse)(nil),             // 11: user.DeletePlaceResponse
+	(*SetDefaultPaymentMethodRequest)(nil),  // 12: user.SetDefaultPaymentMethodRequest
+	(*SetDefaultPaymentMethodResponse)(nil), // 13: user.SetDefaultPaymentMethodResponse
+	(payment.PaymentMethod)(0),              // 14: payment.PaymentMethod
+	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
+}
+var file_user_proto_depIdxs = []int32{
+	0,  // 0: user.Place.type:type_name -> user.PlaceType
+	14, // 1: user.Profile.defaultPaymentMethod:type_name -> payment.PaymentMethod
+	1,  // 2: user.Profile.savedPlaces:type_name -> user.Place
+	15, // 3: user.Profile.createdAt:type_name -> google.protobuf.Timestamp
+	2,  // 4: user.GetProfileResponse.profile:type_name -> user.Profile
+	2,  // 5: user.UpdateProfileResponse.profile:type_name -> user.Profile
+	1,  // 6: user.SavePlaceRequest.place:type_name -> user.Place
+	1,  // 7: user.SavePlaceResponse.place:type_name -> user.Place
+	14, // 8: user.SetDefaultPaymentMethodRequest.paymentMethod:type_name -> payment.PaymentMethod
+	2,  // 9: user.SetDefaultPaymentMethodResponse.profile:type_name -> user.Profile
+	4,  // 10: user.UserService.GetProfile:input_type -> user.GetProfileRequest
+	6,  // 11: user.UserService.UpdateProfile:input_type -> user.UpdateProfileRequest
+	8,  // 12: user.UserService.SavePlace:input_type -> user.SavePlaceRequest
+	10, // 13: user.UserService.DeletePlace:input_type -> user.DeletePlaceRequest
+	12, // 14: user.UserService.SetDefaultPaymentMethod:input_type -> user.SetDefaultPaymentMethodRequest
+	5,  // 15: user.UserService.GetProfile:output_type -> user.GetProfileResponse
+	7,  // 16: user.UserService.UpdateProfile:output_type -> user.UpdateProfileResponse
+	9,  // 17: user.UserService.SavePlace:output_type -> user.SavePlaceResponse
+	11, // 18: user.UserService.DeletePlace:output_type -> user.DeletePlaceResponse
+	13, // 19: user.UserService.SetDefaultPaymentMethod:output_type -> user.SetDefaultPaymentMethodResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_user_proto_init() }
+func file_user_proto_init() {
+	if File_user_proto != nil {
+		return
+	}
+	file_user_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_proto_goTypes,
+		DependencyIndexes: file_user_proto_depIdxs,
+		EnumInfos:         file_user_proto_enumTypes,
+		MessageInfos:      file_user_proto_msgTypes,
+	}.Build()
+	File_user_proto = out.File
+	file_user_proto_goTypes = nil
+	file_user_proto_depIdxs = nil
+}
@@ -1,68 +1,230 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/ride4Low/contracts/events"
+	"github.com/ride4Low/contracts/pkg/vehicle"
 	"github.com/ride4Low/contracts/proto/trip"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Trip statuses
+const (
+	TripStatusScheduled = "scheduled"
+	TripStatusPending   = "pending"
+	TripStatusAccepted  = "accepted"
+	TripStatusCancelled = "cancelled"
+	TripStatusCompleted = "completed"
+
+	TripStatusPaymentFailed  = "payment_failed"
+	TripStatusPaymentExpired = "payment_expired"
+	TripStatusRefunded       = "refunded"
+	TripStatusDisputed       = "disputed"
+)
+
+var paymentEventTripStatuses = map[string]string{
+	events.PaymentEventFailed:   TripStatusPaymentFailed,
+	events.PaymentEventExpired:  TripStatusPaymentExpired,
+	events.PaymentEventRefunded: TripStatusRefunded,
+	events.PaymentEventDisputed: TripStatusDisputed,
+}
+
+// TripStatusForPaymentEvent returns the status a trip transitions to when the given payment event is received.
+func TripStatusForPaymentEvent(routingKey string) (string, bool) {
+	status, ok := paymentEventTripStatuses[routingKey]
+	return status, ok
+}
+
 type Trip struct {
 	ID       primitive.ObjectID `bson:"_id" json:"id"`
 	UserID   string             `bson:"userID"`
 	Status   string             `bson:"status"`
 	RideFare *RideFare          `bson:"rideFare"`
 	Driver   *trip.TripDriver   `bson:"driver"`
+	// ScheduledPickupTime is set for trips booked for later
+	ScheduledPickupTime *time.Time `bson:"scheduledPickupTime,omitempty"`
 }
 
 func (t *Trip) ToProto() *trip.Trip {
+	if t == nil {
+		return nil
+	}
+
+	var route *trip.Route
+	if t.RideFare != nil {
+		route = t.RideFare.Route.ToProto()
+	}
+
+	var scheduledPickupTime *timestamppb.Timestamp
+	if t.ScheduledPickupTime != nil {
+		scheduledPickupTime = timestamppb.New(*t.ScheduledPickupTime)
+	}
+
 	return &trip.Trip{
 		Id:           t.ID.Hex(),
 		UserID:       t.UserID,
 		SelectedFare: t.RideFare.ToProto(),
 		Status:       t.Status,
 		Driver:       t.Driver,
-		Route:        t.RideFare.Route.ToProto(),
+		Route:        route,
+
+		ScheduledPickupTime: scheduledPickupTime,
 	}
 }
 
+// TripFromProto converts a trip.Trip into its BSON model.
+// The trip route is attached to the selected fare, mirroring ToProto, so it is dropped when the trip has no fare.
+func TripFromProto(p *trip.Trip) (*Trip, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	id, err := objectIDFromHex(p.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid trip id: %v", err)
+	}
+
+	rideFare, err := RideFareFromProto(p.GetSelectedFare())
+	if err != nil {
+		return nil, err
+	}
+	if rideFare != nil {
+		rideFare.Route = RouteFromProto(p.GetRoute())
+	}
+
+	var scheduledPickupTime *time.Time
+	if p.GetScheduledPickupTime() != nil {
+		t := p.GetScheduledPickupTime().AsTime()
+		scheduledPickupTime = &t
+	}
+
+	return &Trip{
+		ID:       id,
+		UserID:   p.GetUserID(),
+		Status:   p.GetStatus(),
+		RideFare: rideFare,
+		Driver:   p.GetDriver(),
+
+		ScheduledPickupTime: scheduledPickupTime,
+	}, nil
+}
+
 type RideFare struct {
 	ID                primitive.ObjectID `bson:"_id,omitempty"`
 	UserID            string             `bson:"userID"`
-	PackageSlug       string             `bson:"packageSlug"` // ex: van, luxury, sedan
+	PackageSlug       string             `bson:"packageSlug"` // see vehicle.PackageSlug
 	TotalPriceInCents float64            `bson:"totalPriceInCents"`
 	Route             *OsrmApiResponse   `bson:"route"`
 	CreatedAt         time.Time          `bson:"created_at"`
 }
 
 func (r *RideFare) ToProto() *trip.RideFare {
+	if r == nil {
+		return nil
+	}
+
+	// Unknown slugs are sent as PACKAGE_SLUG_UNSPECIFIED and an empty string
+	slug, _ := vehicle.Parse(r.PackageSlug)
+
+	var createdAt *timestamppb.Timestamp
+	if !r.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(r.CreatedAt)
+	}
+
 	return &trip.RideFare{
 		Id:                r.ID.Hex(),
 		UserID:            r.UserID,
-		PackageSlug:       r.PackageSlug,
+		PackageSlug:       slug.String(),
+		VehiclePackage:    slug.TripProto(),
 		TotalPriceInCents: r.TotalPriceInCents,
+		CreatedAt:         createdAt,
+	}
+}
+
+// RideFareFromProto converts a trip.RideFare into its BSON model.
+// Route is carried by trip.Trip and is left empty. CreatedAt is returned in UTC.
+func RideFareFromProto(p *trip.RideFare) (*RideFare, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	id, err := objectIDFromHex(p.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ride fare id: %v", err)
+	}
+
+	var createdAt time.Time
+	if p.GetCreatedAt() != nil {
+		createdAt = p.GetCreatedAt().AsTime()
 	}
+
+	return &RideFare{
+		ID:                id,
+		UserID:            p.GetUserID(),
+		PackageSlug:       vehicle.FromTripMessage(p).String(),
+		TotalPriceInCents: p.GetTotalPriceInCents(),
+		CreatedAt:         createdAt,
+	}, nil
 }
 
 type OsrmApiResponse struct {
-	Routes []struct {
-		Distance float64 `json:"distance"`
-		Duration float64 `json:"duration"`
-		Geometry struct {
-			Coordinates [][]float64 `json:"coordinates"`
-		} `json:"geometry"`
-	} `json:"routes"`
+	Routes []OsrmRoute `json:"routes"`
+}
+
+// OsrmRoute is a single route returned by the OSRM API.
+// A route through N waypoints has N-1 legs.
+type OsrmRoute struct {
+	Distance float64      `json:"distance"`
+	Duration float64      `json:"duration"`
+	Geometry OsrmGeometry `json:"geometry"`
+	Legs     []OsrmLeg    `json:"legs"`
+}
+
+// OsrmLeg is the part of a route between two consecutive waypoints.
+type OsrmLeg struct {
+	Distance float64 `json:"distance"`
+	Duration float64 `json:"duration"`
+}
+
+// Stops returns the number of intermediate stops on the route.
+func (r OsrmRoute) Stops() int {
+	return max(len(r.Legs)-1, 0)
+}
+
+// OsrmGeometry holds the coordinates of an OSRM route.
+type OsrmGeometry struct {
+	Coordinates [][]float64 `json:"coordinates"`
 }
 
+// ToProto converts the first route of the response, which is the one OSRM recommends.
+// Coordinates are [latitude, longitude] pairs; malformed ones are skipped.
 func (o *OsrmApiResponse) ToProto() *trip.Route {
+	if o == nil || len(o.Routes) == 0 {
+		return nil
+	}
+
 	route := o.Routes[0]
 	geometry := route.Geometry.Coordinates
-	coordinates := make([]*trip.Coordinate, len(geometry))
-	for i, coord := range geometry {
-		coordinates[i] = &trip.Coordinate{
+	coordinates := make([]*trip.Coordinate, 0, len(geometry))
+	for _, coord := range geometry {
+		if len(coord) < 2 {
+			continue
+		}
+		coordinates = append(coordinates, &trip.Coordinate{
 			Latitude:  coord[0],
 			Longitude: coord[1],
-		}
+		})
+	}
+
+	var legs []*trip.RouteLeg
+	for _, leg := range route.Legs {
+		legs = append(legs, &trip.RouteLeg{
+			Distance: leg.Distance,
+			Duration: leg.Duration,
+		})
 	}
 
 	return &trip.Route{
@@ -73,5 +235,50 @@ func (o *OsrmApiResponse) ToProto() *trip.Route {
 		},
 		Distance: route.Distance,
 		Duration: route.Duration,
+		Legs:     legs,
 	}
 }
+
+// RouteFromProto converts a trip.Route into an OsrmApiResponse holding a single route.
+// Coordinates of every geometry are concatenated in order.
+func RouteFromProto(p *trip.Route) *OsrmApiResponse {
+	if p == nil {
+		return nil
+	}
+
+	var coordinates [][]float64
+	for _, geometry := range p.GetGeometry() {
+		for _, coord := range geometry.GetCoordinates() {
+			coordinates = append(coordinates, []float64{coord.GetLatitude(), coord.GetLongitude()})
+		}
+	}
+
+	var legs []OsrmLeg
+	for _, leg := range p.GetLegs() {
+		legs = append(legs, OsrmLeg{
+			Distance: leg.GetDistance(),
+			Duration: leg.GetDuration(),
+		})
+	}
+
+	return &OsrmApiResponse{
+		Routes: []OsrmRoute{
+			{
+				Distance: p.GetDistance(),
+				Duration: p.GetDuration(),
+				Geometry: OsrmGeometry{
+					Coordinates: coordinates,
+				},
+				Legs: legs,
+			},
+		},
+	}
+}
+
+func objectIDFromHex(hex string) (primitive.ObjectID, error) {
+	if hex == "" {
+		return primitive.NilObjectID, nil
+	}
+
+	return primitive.ObjectIDFromHex(hex)
+}
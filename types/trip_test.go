@@ -0,0 +1,197 @@
+package types
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/ride4Low/contracts/pkg/vehicle"
+	"github.com/ride4Low/contracts/proto/trip"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The round trip holds for models whose times are in UTC, whose package slug is known or empty,
+// whose route has exactly one OSRM route made of [latitude, longitude] pairs, and whose route
+// is only set along with a fare.
+
+type tripValue struct{ *Trip }
+
+func (tripValue) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(tripValue{randomTrip(r, size)})
+}
+
+type rideFareValue struct{ *RideFare }
+
+func (rideFareValue) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(rideFareValue{randomRideFare(r, size)})
+}
+
+type routeValue struct{ *OsrmApiResponse }
+
+func (routeValue) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(routeValue{randomRoute(r, size)})
+}
+
+func TestTripRoundTrip(t *testing.T) {
+	f := func(v tripValue) bool {
+		got, err := TripFromProto(v.Trip.ToProto())
+		if err != nil {
+			t.Logf("TripFromProto() error = %v", err)
+			return false
+		}
+		return reflect.DeepEqual(got, v.Trip)
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRideFareRoundTrip(t *testing.T) {
+	f := func(v rideFareValue) bool {
+		want := v.RideFare
+		if want != nil {
+			// The route travels on trip.Trip, not on trip.RideFare
+			copied := *want
+			copied.Route = nil
+			want = &copied
+		}
+
+		got, err := RideFareFromProto(v.RideFare.ToProto())
+		if err != nil {
+			t.Logf("RideFareFromProto() error = %v", err)
+			return false
+		}
+		return reflect.DeepEqual(got, want)
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRouteRoundTrip(t *testing.T) {
+	f := func(v routeValue) bool {
+		return reflect.DeepEqual(RouteFromProto(v.OsrmApiResponse.ToProto()), v.OsrmApiResponse)
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTripToProtoWithoutFare(t *testing.T) {
+	got := (&Trip{ID: primitive.NewObjectID(), Status: TripStatusPending}).ToProto()
+	if got.GetSelectedFare() != nil || got.GetRoute() != nil {
+		t.Errorf("ToProto() = %v, want no fare and no route", got)
+	}
+
+	var nilTrip *Trip
+	if nilTrip.ToProto() != nil {
+		t.Error("ToProto() of a nil trip is not nil")
+	}
+}
+
+func TestRouteToProtoSkipsMalformedCoordinates(t *testing.T) {
+	route := &OsrmApiResponse{Routes: []OsrmRoute{{
+		Geometry: OsrmGeometry{Coordinates: [][]float64{{1, 2}, {3}, {}, {4, 5}}},
+	}}}
+
+	got := RouteFromProto(route.ToProto()).Routes[0].Geometry.Coordinates
+	want := [][]float64{{1, 2}, {4, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("coordinates = %v, want %v", got, want)
+	}
+}
+
+func randomTrip(r *rand.Rand, size int) *Trip {
+	if r.Intn(10) == 0 {
+		return nil
+	}
+
+	statuses := []string{TripStatusScheduled, TripStatusPending, TripStatusAccepted, TripStatusCancelled, TripStatusCompleted}
+	t := &Trip{
+		ID:       randomObjectID(r),
+		UserID:   randomString(r, size),
+		Status:   statuses[r.Intn(len(statuses))],
+		RideFare: randomRideFare(r, size),
+	}
+
+	if r.Intn(2) == 0 {
+		t.Driver = &trip.TripDriver{
+			Id:          randomString(r, size),
+			Name:        randomString(r, size),
+			CarPlate:    randomString(r, size),
+			Rating:      r.Float64() * 5,
+			RatingCount: r.Int31n(1000),
+		}
+	}
+	if r.Intn(2) == 0 {
+		pickup := randomTime(r)
+		t.ScheduledPickupTime = &pickup
+	}
+
+	return t
+}
+
+func randomRideFare(r *rand.Rand, size int) *RideFare {
+	if r.Intn(10) == 0 {
+		return nil
+	}
+
+	slugs := append([]vehicle.PackageSlug{""}, vehicle.All()...)
+	f := &RideFare{
+		UserID:            randomString(r, size),
+		PackageSlug:       slugs[r.Intn(len(slugs))].String(),
+		TotalPriceInCents: float64(r.Intn(100000)),
+		Route:             randomRoute(r, size),
+	}
+	if r.Intn(2) == 0 {
+		f.ID = randomObjectID(r)
+	}
+	if r.Intn(2) == 0 {
+		f.CreatedAt = randomTime(r)
+	}
+
+	return f
+}
+
+func randomRoute(r *rand.Rand, size int) *OsrmApiResponse {
+	if r.Intn(5) == 0 {
+		return nil
+	}
+
+	route := OsrmRoute{
+		Distance: r.Float64() * 100000,
+		Duration: r.Float64() * 10000,
+	}
+	for range r.Intn(size + 1) {
+		route.Geometry.Coordinates = append(route.Geometry.Coordinates, []float64{r.Float64()*180 - 90, r.Float64()*360 - 180})
+	}
+	for range r.Intn(4) {
+		route.Legs = append(route.Legs, OsrmLeg{Distance: r.Float64() * 10000, Duration: r.Float64() * 1000})
+	}
+
+	return &OsrmApiResponse{Routes: []OsrmRoute{route}}
+}
+
+func randomObjectID(r *rand.Rand) primitive.ObjectID {
+	var id primitive.ObjectID
+	r.Read(id[:])
+	return id
+}
+
+func randomTime(r *rand.Rand) time.Time {
+	return time.Unix(r.Int63n(4102444800), r.Int63n(int64(time.Second))).UTC()
+}
+
+func randomString(r *rand.Rand, size int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, r.Intn(size+1))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	return string(b)
+}